@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package chans
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	"spheric.cloud/xstd/constraints"
+)
+
+// Batch groups the values received from in into batches of at most maxSize values.
+// A batch is emitted once it is full or maxWait has passed since its first value was received,
+// whichever comes first. A maxWait of zero or less disables the time-based flush.
+// Timers are created using clock; if clock is nil, RealClock is used.
+//
+// Once in is closed or ctx is done, the pending partial batch is emitted and the returned
+// channel is closed. A full batch that is not received before ctx is done is discarded.
+// The returned channel has to be drained until it is closed.
+func Batch[C constraints.Receive[V], V any](ctx context.Context, in C, maxSize int, maxWait time.Duration, clock Clock) <-chan []V {
+	if maxSize <= 0 {
+		panic("chans.Batch: maxSize must be > 0")
+	}
+	clock = clockOrReal(clock)
+
+	out := make(chan []V)
+	go func() {
+		defer close(out)
+
+		var (
+			batch  []V
+			timer  Timer
+			timerC <-chan time.Time
+		)
+		stopTimer := func() {
+			if timerC != nil {
+				timer.Stop()
+				timerC = nil
+			}
+		}
+		// flush emits the pending batch. It reports false if ctx is done before the batch was taken,
+		// in which case the batch is discarded.
+		flush := func() bool {
+			stopTimer()
+			if len(batch) == 0 {
+				return true
+			}
+			err := Offer(ctx, out, batch)
+			batch = nil
+			return err == nil
+		}
+		defer func() {
+			stopTimer()
+			if len(batch) > 0 {
+				out <- batch
+			}
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timerC:
+				timerC = nil
+				if !flush() {
+					return
+				}
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+
+				batch = append(batch, v)
+				if len(batch) >= maxSize {
+					if !flush() {
+						return
+					}
+					continue
+				}
+				if len(batch) == 1 && maxWait > 0 {
+					if timer == nil {
+						timer = clock.NewTimer(maxWait)
+					} else {
+						timer.Reset(maxWait)
+					}
+					timerC = timer.C()
+				}
+			}
+		}
+	}()
+	return out
+}
+
+// BatchSeq groups the values of seq into batches of at most maxSize values.
+// See Batch for the semantics of maxSize, maxWait and clock.
+//
+// seq is consumed in a separate goroutine, so batches can be flushed while seq is blocked producing
+// the next value. If the consumer stops early or ctx is done, BatchSeq returns once seq yields its
+// next value or finishes.
+func BatchSeq[V any](ctx context.Context, seq iter.Seq[V], maxSize int, maxWait time.Duration, clock Clock) iter.Seq[[]V] {
+	if maxSize <= 0 {
+		panic("chans.BatchSeq: maxSize must be > 0")
+	}
+	return func(yield func([]V) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		in := make(chan V)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			defer close(in)
+			_, _ = OfferSeq[int](ctx, in, seq)
+		}()
+
+		out := Batch(ctx, in, maxSize, maxWait, clock)
+		defer func() {
+			cancel()
+			for range out {
+			}
+			<-done
+		}()
+
+		for batch := range out {
+			if !yield(batch) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package chans
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestBatchSize(t *testing.T) {
+	in := make(chan int)
+	go func() {
+		defer close(in)
+		SendSeq[int](in, slices.Values([]int{1, 2, 3, 4, 5}))
+	}()
+
+	got := slices.Collect(RecvSeq(Batch(context.Background(), in, 2, 0, nil)))
+	want := [][]int{{1, 2}, {3, 4}, {5}}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("Batch() = %v, want %v", got, want)
+	}
+}
+
+func TestBatchWait(t *testing.T) {
+	var (
+		clock = newFakeClock()
+		in    = make(chan int)
+		out   = Batch(context.Background(), in, 10, time.Second, clock)
+	)
+
+	in <- 1
+	in <- 2
+	clock.WaitActive(1)
+	clock.Advance(time.Second)
+	if got, want := <-out, []int{1, 2}; !slices.Equal(got, want) {
+		t.Errorf("<-Batch() = %v, want %v", got, want)
+	}
+
+	in <- 3
+	clock.WaitActive(1)
+	clock.Advance(500 * time.Millisecond)
+	in <- 4
+	clock.Advance(500 * time.Millisecond)
+	if got, want := <-out, []int{3, 4}; !slices.Equal(got, want) {
+		t.Errorf("<-Batch() = %v, want %v", got, want)
+	}
+
+	in <- 5
+	close(in)
+	if got, want := <-out, []int{5}; !slices.Equal(got, want) {
+		t.Errorf("<-Batch() = %v, want %v", got, want)
+	}
+	if _, ok := <-out; ok {
+		t.Error("Batch() not closed")
+	}
+}
+
+func TestBatchCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan int)
+	out := Batch(ctx, in, 10, 0, nil)
+
+	in <- 1
+	in <- 2
+	cancel()
+	got := slices.Collect(RecvSeq(out))
+	want := [][]int{{1, 2}}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("Batch() = %v, want %v", got, want)
+	}
+}
+
+func TestBatchCancelBlockedFlush(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan int)
+	out := Batch(ctx, in, 2, 0, nil)
+
+	// Nobody receives the full batch before ctx is done, so Batch may discard it, but must not
+	// emit it more than once or block forever.
+	in <- 1
+	in <- 2
+	cancel()
+	done := make(chan [][]int)
+	go func() {
+		done <- slices.Collect(RecvSeq(out))
+	}()
+	if got, want := <-done, [][]int{{1, 2}}; len(got) != 0 && !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("Batch() = %v, want %v or empty", got, want)
+	}
+}
+
+func TestBatchSeq(t *testing.T) {
+	got := slices.Collect(BatchSeq(context.Background(), slices.Values([]int{1, 2, 3}), 2, time.Hour, nil))
+	want := [][]int{{1, 2}, {3}}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("BatchSeq() = %v, want %v", got, want)
+	}
+
+	var n int
+	for range BatchSeq(context.Background(), func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}, 2, 0, nil) {
+		n++
+		if n == 3 {
+			break
+		}
+	}
+}
+
+func TestBatchSeqWait(t *testing.T) {
+	var (
+		clock = newFakeClock()
+		c     = make(chan int)
+		got   [][]int
+	)
+	go func() {
+		c <- 1
+		clock.WaitActive(1)
+		clock.Advance(time.Second)
+	}()
+	for batch := range BatchSeq(context.Background(), RecvSeq(c), 10, time.Second, clock) {
+		got = append(got, batch)
+		if len(got) == 1 {
+			go func() {
+				c <- 2
+				close(c)
+			}()
+		}
+	}
+
+	want := [][]int{{1}, {2}}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("BatchSeq() = %v, want %v", got, want)
+	}
+}
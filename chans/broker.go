@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package chans
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+type subscriber[T any] struct {
+	filter func(T) bool
+	policy OverflowPolicy
+	stop   func() bool
+
+	done      chan struct{} // closed when the subscription ends, interrupting a blocked send
+	closeDone sync.Once
+
+	mu      sync.Mutex // guards c against being closed while sending
+	c       chan T
+	closed  bool
+	dropped atomic.Uint64
+}
+
+func (s *subscriber[T]) close() {
+	s.closeDone.Do(func() { close(s.done) })
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.closed {
+		s.closed = true
+		close(s.c)
+	}
+}
+
+func (s *subscriber[T]) publish(ctx context.Context, v T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+
+	if s.policy == Block {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.done:
+			return nil
+		case s.c <- v:
+			return nil
+		}
+	}
+
+	dropped, err := offerPolicy(ctx, s.c, v, s.policy)
+	if dropped {
+		s.dropped.Add(1)
+	}
+	return err
+}
+
+// Broker is an in-process publish/subscribe broker delivering values of type T to its subscribers.
+// A Broker must be created using NewBroker and is safe for concurrent use.
+type Broker[T any] struct {
+	mu     sync.RWMutex
+	subs   map[<-chan T]*subscriber[T]
+	closed bool
+}
+
+// NewBroker creates a new Broker without subscribers.
+func NewBroker[T any]() *Broker[T] {
+	return &Broker[T]{
+		subs: make(map[<-chan T]*subscriber[T]),
+	}
+}
+
+// Subscribe registers a new subscriber and returns the channel it receives values on.
+// Only values for which filter returns true are delivered; a nil filter accepts all values.
+// The channel has a buffer of the given size, and policy determines how Publish handles it being full.
+//
+// The subscription ends and the channel is closed once ctx is done or the Broker is closed.
+func (b *Broker[T]) Subscribe(ctx context.Context, filter func(T) bool, size int, policy OverflowPolicy) <-chan T {
+	if size < 0 {
+		panic("chans.Broker.Subscribe: negative size")
+	}
+
+	s := &subscriber[T]{
+		filter: filter,
+		policy: policy,
+		done:   make(chan struct{}),
+		c:      make(chan T, size),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		s.close()
+		return s.c
+	}
+
+	b.subs[s.c] = s
+	s.stop = context.AfterFunc(ctx, func() { b.unsubscribe(s) })
+	return s.c
+}
+
+func (b *Broker[T]) unsubscribe(s *subscriber[T]) {
+	b.mu.Lock()
+	delete(b.subs, s.c)
+	b.mu.Unlock()
+	s.close()
+}
+
+// Publish delivers v to all subscribers whose filter accepts it, according to their OverflowPolicy.
+// If a subscriber with the Block policy is full, Publish waits until it has room, its subscription ends
+// or ctx is done. A subscription also ends when the Broker is closed. If ctx is done, Publish returns
+// ctx.Err() and v is not delivered to the remaining subscribers.
+func (b *Broker[T]) Publish(ctx context.Context, v T) error {
+	b.mu.RLock()
+	subs := make([]*subscriber[T], 0, len(b.subs))
+	for _, s := range b.subs {
+		subs = append(subs, s)
+	}
+	b.mu.RUnlock()
+
+	for _, s := range subs {
+		if s.filter != nil && !s.filter(v) {
+			continue
+		}
+		if err := s.publish(ctx, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Dropped returns the number of values that were dropped for the subscriber receiving on c due to its OverflowPolicy.
+// The second return value is false if c does not belong to an active subscription.
+func (b *Broker[T]) Dropped(c <-chan T) (uint64, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	s, ok := b.subs[c]
+	if !ok {
+		return 0, false
+	}
+	return s.dropped.Load(), true
+}
+
+// Len returns the number of active subscriptions.
+func (b *Broker[T]) Len() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.subs)
+}
+
+// Close ends all subscriptions and closes their channels.
+// Subscriptions created after Close are closed immediately.
+func (b *Broker[T]) Close() {
+	b.mu.Lock()
+	subs := b.subs
+	b.subs = make(map[<-chan T]*subscriber[T])
+	b.closed = true
+	b.mu.Unlock()
+
+	for _, s := range subs {
+		s.stop()
+		s.close()
+	}
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package chans
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestBroker(t *testing.T) {
+	ctx := context.Background()
+	b := NewBroker[int]()
+	defer b.Close()
+
+	all := b.Subscribe(ctx, nil, 10, Block)
+	even := b.Subscribe(ctx, func(v int) bool { return v%2 == 0 }, 10, Block)
+	if n := b.Len(); n != 2 {
+		t.Errorf("Len() = %d, want 2", n)
+	}
+
+	for i := range 4 {
+		if err := b.Publish(ctx, i); err != nil {
+			t.Fatalf("Publish() = %v", err)
+		}
+	}
+	b.Close()
+
+	if got, want := slices.Collect(RecvSeq(all)), []int{0, 1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("all = %v, want %v", got, want)
+	}
+	if got, want := slices.Collect(RecvSeq(even)), []int{0, 2}; !slices.Equal(got, want) {
+		t.Errorf("even = %v, want %v", got, want)
+	}
+
+	if _, ok := <-b.Subscribe(ctx, nil, 0, Block); ok {
+		t.Error("Subscribe() after Close() not closed")
+	}
+}
+
+func TestBrokerDrop(t *testing.T) {
+	ctx := context.Background()
+	b := NewBroker[int]()
+	defer b.Close()
+
+	newest := b.Subscribe(ctx, nil, 2, DropNewest)
+	oldest := b.Subscribe(ctx, nil, 2, DropOldest)
+	for i := range 5 {
+		if err := b.Publish(ctx, i); err != nil {
+			t.Fatalf("Publish() = %v", err)
+		}
+	}
+
+	for _, c := range []<-chan int{newest, oldest} {
+		if n, ok := b.Dropped(c); !ok || n != 3 {
+			t.Errorf("Dropped() = %d, %v, want 3, true", n, ok)
+		}
+	}
+	if got, want := []int{<-newest, <-newest}, []int{0, 1}; !slices.Equal(got, want) {
+		t.Errorf("DropNewest = %v, want %v", got, want)
+	}
+	if got, want := []int{<-oldest, <-oldest}, []int{3, 4}; !slices.Equal(got, want) {
+		t.Errorf("DropOldest = %v, want %v", got, want)
+	}
+}
+
+func TestBrokerUnsubscribe(t *testing.T) {
+	b := NewBroker[int]()
+	defer b.Close()
+
+	subCtx, cancel := context.WithCancel(context.Background())
+	c := b.Subscribe(subCtx, nil, 0, Block)
+	cancel()
+	for range c {
+	}
+	if n := b.Len(); n != 0 {
+		t.Errorf("Len() = %d, want 0", n)
+	}
+	if _, ok := b.Dropped(c); ok {
+		t.Error("Dropped() ok for ended subscription")
+	}
+	if err := b.Publish(context.Background(), 1); err != nil {
+		t.Errorf("Publish() = %v, want nil", err)
+	}
+}
+
+func TestBrokerPublishCancel(t *testing.T) {
+	b := NewBroker[int]()
+	defer b.Close()
+
+	_ = b.Subscribe(context.Background(), nil, 0, Block)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := b.Publish(ctx, 1); !errors.Is(err, context.Canceled) {
+		t.Errorf("Publish() = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestBrokerCloseWhilePublishBlocked(t *testing.T) {
+	ctx := context.Background()
+	b := NewBroker[int]()
+
+	publishing := make(chan struct{})
+	_ = b.Subscribe(ctx, func(int) bool {
+		close(publishing)
+		return true
+	}, 0, Block)
+
+	errc := make(chan error, 1)
+	go func() { errc <- b.Publish(ctx, 1) }()
+
+	// The publisher is about to block sending to the subscriber, which never receives.
+	<-publishing
+	b.Close()
+	if err := <-errc; err != nil {
+		t.Errorf("Publish() = %v, want nil", err)
+	}
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package chans
+
+import (
+	"time"
+)
+
+// Clock abstracts the passing of time for the timing-based operators of this package,
+// so that they can be tested deterministically.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// NewTimer creates a new Timer that fires after d.
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is a single-shot timer created by a Clock. It mirrors time.Timer.
+type Timer interface {
+	// C returns the channel the current time is sent on when the Timer fires.
+	C() <-chan time.Time
+	// Stop prevents the Timer from firing. It reports whether the Timer was active.
+	Stop() bool
+	// Reset changes the Timer to fire after d. It reports whether the Timer was active.
+	Reset(d time.Duration) bool
+}
+
+// RealClock is a Clock backed by the time package.
+var RealClock Clock = realClock{}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	return realTimer{time.NewTimer(d)}
+}
+
+type realTimer struct {
+	t *time.Timer
+}
+
+func (t realTimer) C() <-chan time.Time {
+	return t.t.C
+}
+
+func (t realTimer) Stop() bool {
+	return t.t.Stop()
+}
+
+func (t realTimer) Reset(d time.Duration) bool {
+	return t.t.Reset(d)
+}
+
+func clockOrReal(clock Clock) Clock {
+	if clock == nil {
+		return RealClock
+	}
+	return clock
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package chans
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNoFutures is the error of the Future returned by Any and Race when called without futures.
+var ErrNoFutures = errors.New("chans: no futures")
+
+// Future is the eventual result of an asynchronous computation.
+// Its result is computed once and cached, so any number of callers can await it.
+type Future[T any] struct {
+	done chan struct{}
+	v    T
+	err  error
+}
+
+func newFuture[T any]() *Future[T] {
+	return &Future[T]{done: make(chan struct{})}
+}
+
+func (f *Future[T]) complete(v T, err error) {
+	f.v, f.err = v, err
+	close(f.done)
+}
+
+// Go calls fn with ctx in a new goroutine and returns a Future for its result.
+func Go[T any](ctx context.Context, fn func(context.Context) (T, error)) *Future[T] {
+	f := newFuture[T]()
+	go func() {
+		f.complete(fn(ctx))
+	}()
+	return f
+}
+
+// Done returns a channel that is closed once the result of f is available.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Await blocks until the result of f is available and returns it.
+// If ctx is done first, Await returns the zero value and ctx.Err(); the computation of f is not affected.
+func (f *Future[T]) Await(ctx context.Context) (T, error) {
+	select {
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	case <-f.done:
+		return f.v, f.err
+	}
+}
+
+// whenDone sends the index of each future of fs to the returned channel once it is done.
+// The channel is buffered to hold all indices, so no goroutine is leaked if it is not drained.
+func whenDone[T any](fs []*Future[T]) <-chan int {
+	c := make(chan int, len(fs))
+	for i, f := range fs {
+		go func() {
+			<-f.done
+			c <- i
+		}()
+	}
+	return c
+}
+
+// All returns a Future for the values of all fs, in the order of fs.
+// It fails with the error of the first of fs to fail, without waiting for the others.
+func All[T any](fs ...*Future[T]) *Future[[]T] {
+	res := newFuture[[]T]()
+	go func() {
+		c := whenDone(fs)
+		for range fs {
+			if err := fs[<-c].err; err != nil {
+				res.complete(nil, err)
+				return
+			}
+		}
+
+		vs := make([]T, len(fs))
+		for i, f := range fs {
+			vs[i] = f.v
+		}
+		res.complete(vs, nil)
+	}()
+	return res
+}
+
+// Any returns a Future for the value of the first of fs to succeed.
+// If all of fs fail, it fails with the errors of fs joined using errors.Join.
+// If fs is empty, it fails with ErrNoFutures.
+func Any[T any](fs ...*Future[T]) *Future[T] {
+	res := newFuture[T]()
+	if len(fs) == 0 {
+		var zero T
+		res.complete(zero, ErrNoFutures)
+		return res
+	}
+
+	go func() {
+		c := whenDone(fs)
+		for range fs {
+			if f := fs[<-c]; f.err == nil {
+				res.complete(f.v, nil)
+				return
+			}
+		}
+
+		errs := make([]error, len(fs))
+		for i, f := range fs {
+			errs[i] = f.err
+		}
+		var zero T
+		res.complete(zero, errors.Join(errs...))
+	}()
+	return res
+}
+
+// Race returns a Future for the result of the first of fs to complete, regardless of whether it failed.
+// If fs is empty, it fails with ErrNoFutures.
+func Race[T any](fs ...*Future[T]) *Future[T] {
+	res := newFuture[T]()
+	if len(fs) == 0 {
+		var zero T
+		res.complete(zero, ErrNoFutures)
+		return res
+	}
+
+	go func() {
+		f := fs[<-whenDone(fs)]
+		res.complete(f.v, f.err)
+	}()
+	return res
+}
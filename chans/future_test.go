@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package chans
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+)
+
+func value[T any](v T) func(context.Context) (T, error) {
+	return func(context.Context) (T, error) { return v, nil }
+}
+
+func failure[T any](err error) func(context.Context) (T, error) {
+	return func(context.Context) (T, error) {
+		var zero T
+		return zero, err
+	}
+}
+
+func blocked[T any](release <-chan struct{}) func(context.Context) (T, error) {
+	return func(context.Context) (T, error) {
+		<-release
+		var zero T
+		return zero, nil
+	}
+}
+
+func TestFuture(t *testing.T) {
+	ctx := context.Background()
+	var calls atomic.Int32
+	f := Go(ctx, func(context.Context) (int, error) {
+		calls.Add(1)
+		return 42, nil
+	})
+
+	for range 3 {
+		if v, err := f.Await(ctx); v != 42 || err != nil {
+			t.Errorf("Await() = %d, %v, want 42, nil", v, err)
+		}
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("fn called %d times, want 1", n)
+	}
+	select {
+	case <-f.Done():
+	default:
+		t.Error("Done() not closed")
+	}
+}
+
+func TestFutureAwaitCancel(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	f := Go(context.Background(), blocked[int](release))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := f.Await(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Await() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestAll(t *testing.T) {
+	ctx := context.Background()
+	vs, err := All(Go(ctx, value(1)), Go(ctx, value(2)), Go(ctx, value(3))).Await(ctx)
+	if err != nil || !slices.Equal(vs, []int{1, 2, 3}) {
+		t.Errorf("All() = %v, %v, want [1 2 3], nil", vs, err)
+	}
+
+	release := make(chan struct{})
+	defer close(release)
+	if _, err := All(Go(ctx, blocked[int](release)), Go(ctx, failure[int](errTest))).Await(ctx); err != errTest {
+		t.Errorf("All() error = %v, want %v", err, errTest)
+	}
+
+	if vs, err := All[int]().Await(ctx); err != nil || len(vs) != 0 {
+		t.Errorf("All() = %v, %v, want [], nil", vs, err)
+	}
+}
+
+func TestAny(t *testing.T) {
+	ctx := context.Background()
+	release := make(chan struct{})
+	defer close(release)
+	if v, err := Any(Go(ctx, failure[int](errTest)), Go(ctx, blocked[int](release)), Go(ctx, value(2))).Await(ctx); v != 2 || err != nil {
+		t.Errorf("Any() = %d, %v, want 2, nil", v, err)
+	}
+
+	errOther := errors.New("other")
+	_, err := Any(Go(ctx, failure[int](errTest)), Go(ctx, failure[int](errOther))).Await(ctx)
+	if !errors.Is(err, errTest) || !errors.Is(err, errOther) {
+		t.Errorf("Any() error = %v, want both errors", err)
+	}
+
+	if _, err := Any[int]().Await(ctx); err != ErrNoFutures {
+		t.Errorf("Any() error = %v, want %v", err, ErrNoFutures)
+	}
+}
+
+func TestRace(t *testing.T) {
+	ctx := context.Background()
+	release := make(chan struct{})
+	defer close(release)
+	if _, err := Race(Go(ctx, blocked[int](release)), Go(ctx, failure[int](errTest))).Await(ctx); err != errTest {
+		t.Errorf("Race() error = %v, want %v", err, errTest)
+	}
+
+	if _, err := Race[int]().Await(ctx); err != ErrNoFutures {
+		t.Errorf("Race() error = %v, want %v", err, ErrNoFutures)
+	}
+}
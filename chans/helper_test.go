@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package chans
+
+import (
+	"sync"
+	"time"
+)
+
+// fakeClock is a Clock whose time only advances when Advance is called.
+type fakeClock struct {
+	mu      sync.Mutex
+	cond    sync.Cond
+	now     time.Time
+	timers  []*fakeTimer
+	started int
+}
+
+func newFakeClock() *fakeClock {
+	c := &fakeClock{now: time.Unix(0, 0)}
+	c.cond.L = &c.mu
+	return c
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) NewTimer(d time.Duration) Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := &fakeTimer{clock: c, ch: make(chan time.Time, 1)}
+	c.timers = append(c.timers, t)
+	t.reset(d)
+	return t
+}
+
+// Advance advances the time by d and fires all timers that are due.
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	for _, t := range c.timers {
+		if t.active && !t.at.After(c.now) {
+			t.active = false
+			select {
+			case t.ch <- c.now:
+			default:
+			}
+		}
+	}
+}
+
+// WaitActive blocks until at least n timers are active.
+func (c *fakeClock) WaitActive(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for c.active() < n {
+		c.cond.Wait()
+	}
+}
+
+// WaitStarted blocks until timers have been started or reset at least n times in total.
+func (c *fakeClock) WaitStarted(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for c.started < n {
+		c.cond.Wait()
+	}
+}
+
+func (c *fakeClock) active() int {
+	var n int
+	for _, t := range c.timers {
+		if t.active {
+			n++
+		}
+	}
+	return n
+}
+
+type fakeTimer struct {
+	clock  *fakeClock
+	ch     chan time.Time
+	at     time.Time
+	active bool
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.ch
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	wasActive := t.active
+	t.active = false
+	t.drain()
+	return wasActive
+}
+
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	wasActive := t.active
+	t.drain()
+	t.reset(d)
+	return wasActive
+}
+
+func (t *fakeTimer) drain() {
+	select {
+	case <-t.ch:
+	default:
+	}
+}
+
+// reset has to be called with the clock's lock held.
+func (t *fakeTimer) reset(d time.Duration) {
+	t.at = t.clock.now.Add(d)
+	t.active = true
+	t.clock.started++
+	t.clock.cond.Broadcast()
+}
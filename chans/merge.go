@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package chans
+
+import (
+	"context"
+	"sync"
+
+	"spheric.cloud/xstd/constraints"
+)
+
+// Merge multiplexes the values received from all cs into a single channel.
+// The returned channel is closed once all cs are closed or ctx is done.
+// No goroutine started by Merge outlives the returned channel being closed.
+func Merge[C constraints.Receive[V], V any](ctx context.Context, cs ...C) <-chan V {
+	var (
+		out = make(chan V)
+		wg  sync.WaitGroup
+	)
+	for _, c := range cs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case v, ok := <-c:
+					if !ok {
+						return
+					}
+					if err := Offer(ctx, out, v); err != nil {
+						return
+					}
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// Broadcast copies every value received from in to n channels with the given buffer size.
+// If a channel's buffer is full, policy determines whether Broadcast waits for it or drops values.
+// With Block, a single slow receiver holds back all others.
+// The returned channels are closed once in is closed or ctx is done.
+func Broadcast[C constraints.Receive[V], V any](ctx context.Context, in C, n, size int, policy OverflowPolicy) []<-chan V {
+	if n < 0 {
+		panic("chans.Broadcast: negative n")
+	}
+	if size < 0 {
+		panic("chans.Broadcast: negative size")
+	}
+
+	var (
+		outs = make([]chan V, n)
+		res  = make([]<-chan V, n)
+	)
+	for i := range outs {
+		outs[i] = make(chan V, size)
+		res[i] = outs[i]
+	}
+
+	go func() {
+		defer func() {
+			for _, out := range outs {
+				close(out)
+			}
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+				for _, out := range outs {
+					if _, err := offerPolicy(ctx, out, v, policy); err != nil {
+						return
+					}
+				}
+			}
+		}
+	}()
+	return res
+}
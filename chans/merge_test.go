@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package chans
+
+import (
+	"context"
+	"slices"
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+	c1 := make(chan int)
+	c2 := make(chan int)
+	go func() {
+		defer close(c1)
+		SendSeq[int](c1, slices.Values([]int{1, 3, 5}))
+	}()
+	go func() {
+		defer close(c2)
+		SendSeq[int](c2, slices.Values([]int{2, 4}))
+	}()
+
+	got := slices.Sorted(RecvSeq(Merge(context.Background(), c1, c2)))
+	want := []int{1, 2, 3, 4, 5}
+	if !slices.Equal(got, want) {
+		t.Errorf("Merge() = %v, want %v", got, want)
+	}
+}
+
+func TestMergeCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	c := make(chan int)
+	out := Merge(ctx, c)
+	cancel()
+	for range out {
+	}
+}
+
+func TestBroadcast(t *testing.T) {
+	in := make(chan int)
+	outs := Broadcast(context.Background(), in, 3, 0, Block)
+	go func() {
+		defer close(in)
+		SendSeq[int](in, slices.Values([]int{1, 2, 3}))
+	}()
+
+	got := make([][]int, len(outs))
+	done := make(chan int)
+	for i, out := range outs {
+		go func() {
+			got[i] = slices.Collect(RecvSeq(out))
+			done <- i
+		}()
+	}
+	for range outs {
+		<-done
+	}
+	for i := range got {
+		if want := []int{1, 2, 3}; !slices.Equal(got[i], want) {
+			t.Errorf("Broadcast()[%d] = %v, want %v", i, got[i], want)
+		}
+	}
+}
+
+func TestBroadcastDrop(t *testing.T) {
+	for _, tc := range []struct {
+		policy OverflowPolicy
+		want   []int
+	}{
+		{DropNewest, []int{1, 2}},
+		{DropOldest, []int{4, 5}},
+	} {
+		in := make(chan int, 5)
+		SendSeq[int](in, slices.Values([]int{1, 2, 3, 4, 5}))
+		close(in)
+
+		outs := Broadcast(context.Background(), in, 2, 2, tc.policy)
+		fast := slices.Collect(RecvSeq(outs[0]))
+		slow := slices.Collect(RecvSeq(outs[1]))
+		if len(fast) < 2 || len(fast) > 5 {
+			t.Errorf("Broadcast(%d)[0] = %v, want 2 to 5 values", tc.policy, fast)
+		}
+		if !slices.Equal(slow, tc.want) {
+			t.Errorf("Broadcast(%d)[1] = %v, want %v", tc.policy, slow, tc.want)
+		}
+	}
+}
+
+func TestBroadcastCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan int)
+	outs := Broadcast(ctx, in, 2, 0, Block)
+	go func() { in <- 1 }()
+	<-outs[0]
+	cancel()
+	for _, out := range outs {
+		for range out {
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package chans
+
+import (
+	"context"
+)
+
+// OverflowPolicy determines what happens when a value is sent to a channel whose buffer is full.
+type OverflowPolicy int
+
+const (
+	// Block waits until there is room in the channel or the context is done.
+	Block OverflowPolicy = iota
+	// DropNewest discards the value being sent.
+	DropNewest
+	// DropOldest discards the oldest buffered value to make room for the value being sent.
+	// For unbuffered channels, it behaves like DropNewest.
+	DropOldest
+)
+
+// offerPolicy sends v to c according to policy.
+// It reports whether a value was dropped, and returns ctx.Err() if ctx is done while blocking.
+// c has to be owned by the caller, as DropOldest receives from it.
+func offerPolicy[V any](ctx context.Context, c chan V, v V, policy OverflowPolicy) (dropped bool, err error) {
+	switch policy {
+	case DropNewest:
+		select {
+		case c <- v:
+			return false, nil
+		default:
+			return true, nil
+		}
+	case DropOldest:
+		if cap(c) == 0 {
+			return offerPolicy(ctx, c, v, DropNewest)
+		}
+		for {
+			select {
+			case c <- v:
+				return dropped, nil
+			default:
+			}
+
+			select {
+			case <-c:
+				dropped = true
+			default:
+			}
+		}
+	default:
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case c <- v:
+			return false, nil
+		}
+	}
+}
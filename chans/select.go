@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package chans
+
+import (
+	"context"
+	"iter"
+	"reflect"
+
+	"spheric.cloud/xstd/constraints"
+)
+
+// Case is a receive or send operation for Select.
+// It has to be created using RecvCase or SendCase.
+type Case[V any] struct {
+	c reflect.SelectCase
+}
+
+// RecvCase creates a Case that receives from c.
+func RecvCase[C constraints.Receive[V], V any](c C) Case[V] {
+	return Case[V]{reflect.SelectCase{
+		Dir:  reflect.SelectRecv,
+		Chan: reflect.ValueOf(c),
+	}}
+}
+
+// SendCase creates a Case that sends v to c.
+func SendCase[C constraints.Send[V], V any](c C, v V) Case[V] {
+	return Case[V]{reflect.SelectCase{
+		Dir:  reflect.SelectSend,
+		Chan: reflect.ValueOf(c),
+		Send: reflect.ValueOf(&v).Elem(),
+	}}
+}
+
+func selectCases[V any](ctx context.Context, cases []Case[V]) []reflect.SelectCase {
+	res := make([]reflect.SelectCase, len(cases)+1)
+	for i, c := range cases {
+		res[i] = c.c
+	}
+	res[len(cases)] = reflect.SelectCase{
+		Dir:  reflect.SelectRecv,
+		Chan: reflect.ValueOf(ctx.Done()),
+	}
+	return res
+}
+
+func recvValue[V any](recv reflect.Value) V {
+	if !recv.IsValid() {
+		var zero V
+		return zero
+	}
+	// The comma-ok form yields the zero value for a nil interface value.
+	v, _ := recv.Interface().(V)
+	return v
+}
+
+// Select waits until one of cases can proceed and performs it, like a select statement with
+// a case per element of cases. If multiple cases can proceed, one of them is chosen at random.
+//
+// It returns the index of the chosen case. For a receive case, it also returns the received value and
+// whether it was sent, which is false if the channel is closed. For a send case, ok is always true.
+// If ctx is done before any case can proceed, Select returns -1 and ctx.Err().
+func Select[V any](ctx context.Context, cases ...Case[V]) (i int, v V, ok bool, err error) {
+	chosen, recv, recvOK := reflect.Select(selectCases(ctx, cases))
+	if chosen == len(cases) {
+		return -1, v, false, ctx.Err()
+	}
+	if cases[chosen].c.Dir == reflect.SelectSend {
+		return chosen, v, true, nil
+	}
+	return chosen, recvValue[V](recv), recvOK, nil
+}
+
+// SelectSeq returns an iterator over the values received from cs, together with the index of the
+// channel they were received from. It keeps receiving from whichever channel is ready until all cs
+// are closed or ctx is done. Nil channels in cs are ignored.
+func SelectSeq[C constraints.Receive[V], V any](ctx context.Context, cs ...C) iter.Seq2[int, V] {
+	return func(yield func(int, V) bool) {
+		cases := make([]Case[V], len(cs))
+		open := len(cs)
+		for i, c := range cs {
+			cases[i] = RecvCase(c)
+			if c == nil {
+				// A zero Chan makes reflect.Select ignore the case.
+				cases[i].c.Chan = reflect.Value{}
+				open--
+			}
+		}
+
+		sel := selectCases(ctx, cases)
+		for open > 0 {
+			chosen, recv, ok := reflect.Select(sel)
+			if chosen == len(cases) {
+				return
+			}
+			if !ok {
+				sel[chosen].Chan = reflect.Value{}
+				open--
+				continue
+			}
+			if !yield(chosen, recvValue[V](recv)) {
+				return
+			}
+		}
+	}
+}
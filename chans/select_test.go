@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package chans
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestSelect(t *testing.T) {
+	ctx := context.Background()
+	a, b := make(chan int), make(chan int, 1)
+
+	b <- 2
+	if i, v, ok, err := Select(ctx, RecvCase(a), RecvCase(b)); i != 1 || v != 2 || !ok || err != nil {
+		t.Errorf("Select() = %d, %d, %v, %v, want 1, 2, true, nil", i, v, ok, err)
+	}
+
+	if i, _, ok, err := Select(ctx, RecvCase(a), SendCase(b, 3)); i != 1 || !ok || err != nil {
+		t.Errorf("Select() = %d, %v, %v, want 1, true, nil", i, ok, err)
+	}
+	if v := <-b; v != 3 {
+		t.Errorf("sent %d, want 3", v)
+	}
+
+	close(a)
+	if i, v, ok, err := Select(ctx, RecvCase(a)); i != 0 || v != 0 || ok || err != nil {
+		t.Errorf("Select() = %d, %d, %v, %v, want 0, 0, false, nil", i, v, ok, err)
+	}
+}
+
+func TestSelectInterface(t *testing.T) {
+	c := make(chan error, 1)
+	c <- nil
+	if _, v, ok, err := Select(context.Background(), RecvCase(c)); v != nil || !ok || err != nil {
+		t.Errorf("Select() = %v, %v, %v, want nil, true, nil", v, ok, err)
+	}
+
+	if _, _, _, err := Select(context.Background(), SendCase(c, error(nil))); err != nil {
+		t.Errorf("Select() error = %v, want nil", err)
+	}
+}
+
+func TestSelectCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if i, _, _, err := Select(ctx, RecvCase(make(chan int))); i != -1 || !errors.Is(err, context.Canceled) {
+		t.Errorf("Select() = %d, %v, want -1, %v", i, err, context.Canceled)
+	}
+}
+
+func TestSelectSeq(t *testing.T) {
+	cs := []chan int{make(chan int), nil, make(chan int)}
+	go func() {
+		cs[0] <- 1
+		cs[2] <- 3
+		close(cs[0])
+		cs[2] <- 4
+		close(cs[2])
+	}()
+
+	got := make(map[int][]int)
+	for i, v := range SelectSeq(context.Background(), cs...) {
+		got[i] = append(got[i], v)
+	}
+	want := map[int][]int{0: {1}, 2: {3, 4}}
+	if !maps.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("SelectSeq() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,210 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package chans
+
+import (
+	"context"
+	"time"
+
+	"spheric.cloud/xstd/constraints"
+)
+
+// startTimer starts *timer to fire after d, creating it using clock if necessary, and returns its channel.
+func startTimer(clock Clock, timer *Timer, d time.Duration) <-chan time.Time {
+	if *timer == nil {
+		*timer = clock.NewTimer(d)
+	} else {
+		(*timer).Reset(d)
+	}
+	return (*timer).C()
+}
+
+// Throttle forwards the values received from in, emitting at most one value per interval.
+// The first value is emitted immediately and opens a window of the given interval. Values received
+// during the window replace each other, and the latest one is emitted when the window ends, opening
+// the next window. Timers are created using clock; if clock is nil, RealClock is used.
+//
+// Once in is closed, a pending value is emitted and the returned channel is closed.
+// If ctx is done, the returned channel is closed without emitting a pending value.
+func Throttle[C constraints.Receive[V], V any](ctx context.Context, in C, interval time.Duration, clock Clock) <-chan V {
+	if interval <= 0 {
+		panic("chans.Throttle: interval must be > 0")
+	}
+	clock = clockOrReal(clock)
+
+	out := make(chan V)
+	go func() {
+		defer close(out)
+
+		var (
+			pending    V
+			hasPending bool
+			timer      Timer
+			timerC     <-chan time.Time
+		)
+		defer func() {
+			if timer != nil {
+				timer.Stop()
+			}
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timerC:
+				timerC = nil
+				if hasPending {
+					if Offer(ctx, out, pending) != nil {
+						return
+					}
+					var zero V
+					pending, hasPending = zero, false
+					timerC = startTimer(clock, &timer, interval)
+				}
+			case v, ok := <-in:
+				if !ok {
+					if hasPending {
+						_ = Offer(ctx, out, pending)
+					}
+					return
+				}
+
+				if timerC != nil {
+					pending, hasPending = v, true
+					continue
+				}
+				if Offer(ctx, out, v) != nil {
+					return
+				}
+				timerC = startTimer(clock, &timer, interval)
+			}
+		}
+	}()
+	return out
+}
+
+// Debounce forwards the latest value received from in once no further value has been received for wait.
+// Bursts of values are thus coalesced into their last value. Timers are created using clock;
+// if clock is nil, RealClock is used.
+//
+// Once in is closed, a pending value is emitted and the returned channel is closed.
+// If ctx is done, the returned channel is closed without emitting a pending value.
+func Debounce[C constraints.Receive[V], V any](ctx context.Context, in C, wait time.Duration, clock Clock) <-chan V {
+	if wait <= 0 {
+		panic("chans.Debounce: wait must be > 0")
+	}
+	clock = clockOrReal(clock)
+
+	out := make(chan V)
+	go func() {
+		defer close(out)
+
+		var (
+			pending V
+			timer   Timer
+			timerC  <-chan time.Time
+		)
+		defer func() {
+			if timer != nil {
+				timer.Stop()
+			}
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timerC:
+				timerC = nil
+				if Offer(ctx, out, pending) != nil {
+					return
+				}
+				var zero V
+				pending = zero
+			case v, ok := <-in:
+				if !ok {
+					if timerC != nil {
+						_ = Offer(ctx, out, pending)
+					}
+					return
+				}
+
+				pending = v
+				timerC = startTimer(clock, &timer, wait)
+			}
+		}
+	}()
+	return out
+}
+
+// RateLimit forwards the values received from in, limited by a token bucket that holds up to burst tokens
+// and gains a token every interval. The bucket starts full, and each forwarded value takes a token.
+// If the bucket is empty, RateLimit waits for the next token before receiving further values from in,
+// so no values are dropped. Timers are created using clock; if clock is nil, RealClock is used.
+//
+// Once in is closed or ctx is done, the returned channel is closed.
+func RateLimit[C constraints.Receive[V], V any](ctx context.Context, in C, interval time.Duration, burst int, clock Clock) <-chan V {
+	if interval <= 0 {
+		panic("chans.RateLimit: interval must be > 0")
+	}
+	if burst <= 0 {
+		panic("chans.RateLimit: burst must be > 0")
+	}
+	clock = clockOrReal(clock)
+
+	out := make(chan V)
+	go func() {
+		defer close(out)
+
+		var timer Timer
+		defer func() {
+			if timer != nil {
+				timer.Stop()
+			}
+		}()
+
+		tokens := burst
+		last := clock.Now()
+		refill := func(now time.Time) {
+			n := int(now.Sub(last) / interval)
+			if tokens+n >= burst {
+				tokens = burst
+				last = now
+				return
+			}
+			tokens += n
+			last = last.Add(time.Duration(n) * interval)
+		}
+
+		for {
+			var v V
+			select {
+			case <-ctx.Done():
+				return
+			case w, ok := <-in:
+				if !ok {
+					return
+				}
+				v = w
+			}
+
+			refill(clock.Now())
+			for tokens == 0 {
+				select {
+				case <-ctx.Done():
+					return
+				case now := <-startTimer(clock, &timer, interval-clock.Now().Sub(last)):
+					refill(now)
+				}
+			}
+
+			tokens--
+			if Offer(ctx, out, v) != nil {
+				return
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package chans
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestThrottle(t *testing.T) {
+	var (
+		clock = newFakeClock()
+		in    = make(chan int)
+		out   = Throttle(context.Background(), in, time.Second, clock)
+	)
+
+	in <- 1
+	if got := <-out; got != 1 {
+		t.Errorf("<-Throttle() = %d, want 1", got)
+	}
+
+	clock.WaitStarted(1)
+	in <- 2
+	in <- 3
+	clock.Advance(time.Second)
+	if got := <-out; got != 3 {
+		t.Errorf("<-Throttle() = %d, want 3", got)
+	}
+
+	clock.WaitStarted(2)
+	clock.Advance(time.Second)
+	in <- 4
+	close(in)
+	if got, want := slices.Collect(RecvSeq(out)), []int{4}; !slices.Equal(got, want) {
+		t.Errorf("Throttle() = %v, want %v", got, want)
+	}
+}
+
+func TestDebounce(t *testing.T) {
+	var (
+		clock = newFakeClock()
+		in    = make(chan int)
+		out   = Debounce(context.Background(), in, time.Second, clock)
+	)
+
+	in <- 1
+	clock.WaitStarted(1)
+	clock.Advance(500 * time.Millisecond)
+	in <- 2
+	clock.WaitStarted(2)
+	clock.Advance(500 * time.Millisecond)
+	select {
+	case v := <-out:
+		t.Fatalf("<-Debounce() = %d before wait passed", v)
+	default:
+	}
+	clock.Advance(500 * time.Millisecond)
+	if got := <-out; got != 2 {
+		t.Errorf("<-Debounce() = %d, want 2", got)
+	}
+
+	in <- 3
+	in <- 4
+	close(in)
+	if got, want := slices.Collect(RecvSeq(out)), []int{4}; !slices.Equal(got, want) {
+		t.Errorf("Debounce() = %v, want %v", got, want)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	var (
+		clock = newFakeClock()
+		in    = make(chan int)
+		out   = RateLimit(context.Background(), in, time.Second, 2, clock)
+	)
+	defer close(in)
+
+	for i := range 2 {
+		in <- i
+		if got := <-out; got != i {
+			t.Errorf("<-RateLimit() = %d, want %d", got, i)
+		}
+	}
+
+	in <- 2
+	clock.WaitStarted(1)
+	select {
+	case v := <-out:
+		t.Fatalf("<-RateLimit() = %d without a token", v)
+	default:
+	}
+	clock.Advance(time.Second)
+	if got := <-out; got != 2 {
+		t.Errorf("<-RateLimit() = %d, want 2", got)
+	}
+
+	clock.Advance(10 * time.Second)
+	for i := 3; i < 5; i++ {
+		in <- i
+		if got := <-out; got != i {
+			t.Errorf("<-RateLimit() = %d, want %d", got, i)
+		}
+	}
+
+	in <- 5
+	clock.WaitStarted(2)
+	clock.Advance(time.Second)
+	if got := <-out; got != 5 {
+		t.Errorf("<-RateLimit() = %d, want 5", got)
+	}
+}
+
+func TestRateLimitCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	out := RateLimit(ctx, make(chan int), time.Second, 1, newFakeClock())
+	cancel()
+	if _, ok := <-out; ok {
+		t.Error("RateLimit() not closed")
+	}
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package chans
+
+import (
+	"sync/atomic"
+
+	"spheric.cloud/xstd/container/squeue"
+)
+
+// Unbounded is a channel whose sends never block, as values are buffered without limit.
+// Values sent to In are received from Out in the same order.
+//
+// In has to be closed once no more values are sent. Out is closed once In is closed and
+// all buffered values have been received.
+type Unbounded[V any] struct {
+	in  chan V
+	out chan V
+	len atomic.Int64
+
+	highWaterMark int
+	onHighWater   func(n int)
+}
+
+// NewUnbounded creates a new Unbounded channel and starts its buffering goroutine.
+//
+// If highWaterMark is positive and onHighWater is not nil, onHighWater is called with the current
+// number of buffered values whenever it reaches highWaterMark. It is called again only after the
+// number of buffered values dropped below highWaterMark in between. onHighWater is called from the
+// buffering goroutine and must not block.
+func NewUnbounded[V any](highWaterMark int, onHighWater func(n int)) *Unbounded[V] {
+	u := &Unbounded[V]{
+		in:            make(chan V),
+		out:           make(chan V),
+		highWaterMark: highWaterMark,
+		onHighWater:   onHighWater,
+	}
+	go u.run()
+	return u
+}
+
+// In returns the send side of the channel.
+func (u *Unbounded[V]) In() chan<- V {
+	return u.in
+}
+
+// Out returns the receive side of the channel.
+func (u *Unbounded[V]) Out() <-chan V {
+	return u.out
+}
+
+// Len returns the number of values sent to In that have not been received from Out yet.
+func (u *Unbounded[V]) Len() int {
+	return int(u.len.Load())
+}
+
+func (u *Unbounded[V]) run() {
+	defer close(u.out)
+
+	var (
+		in    = u.in
+		q     = squeue.New[V](0)
+		above bool
+
+		// next is the value to be received from Out next. It is kept outside of q,
+		// as it has to be available for the select below.
+		next    V
+		hasNext bool
+	)
+	for in != nil || hasNext {
+		var out chan V
+		if hasNext {
+			out = u.out
+		}
+
+		select {
+		case v, ok := <-in:
+			if !ok {
+				in = nil
+				continue
+			}
+
+			if hasNext {
+				q.Enqueue(v)
+			} else {
+				next, hasNext = v, true
+			}
+
+			n := int(u.len.Add(1))
+			if u.highWaterMark > 0 && n >= u.highWaterMark && !above {
+				above = true
+				if u.onHighWater != nil {
+					u.onHighWater(n)
+				}
+			}
+		case out <- next:
+			n := int(u.len.Add(-1))
+			if n < u.highWaterMark {
+				above = false
+			}
+			next, hasNext = q.Dequeue()
+		}
+	}
+}
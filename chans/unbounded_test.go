@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package chans
+
+import (
+	"runtime"
+	"slices"
+	"testing"
+)
+
+func TestUnbounded(t *testing.T) {
+	var marks []int
+	u := NewUnbounded[int](3, func(n int) { marks = append(marks, n) })
+
+	for i := range 10 {
+		u.In() <- i
+	}
+	// The last value may still be in transit to the buffer.
+	for u.Len() < 10 {
+		runtime.Gosched()
+	}
+	if n := u.Len(); n != 10 {
+		t.Errorf("Len() = %d, want 10", n)
+	}
+
+	for i := range 8 {
+		if v := <-u.Out(); v != i {
+			t.Errorf("<-Out() = %d, want %d", v, i)
+		}
+	}
+	u.In() <- 10
+	u.In() <- 11
+	close(u.In())
+
+	got := slices.Collect(RecvSeq(u.Out()))
+	if want := []int{8, 9, 10, 11}; !slices.Equal(got, want) {
+		t.Errorf("Out() = %v, want %v", got, want)
+	}
+	if n := u.Len(); n != 0 {
+		t.Errorf("Len() = %d, want 0", n)
+	}
+	if want := []int{3, 3}; !slices.Equal(marks, want) {
+		t.Errorf("high water marks = %v, want %v", marks, want)
+	}
+}
+
+func TestUnboundedClose(t *testing.T) {
+	u := NewUnbounded[int](0, nil)
+	close(u.In())
+	if _, ok := <-u.Out(); ok {
+		t.Error("Out() not closed")
+	}
+}
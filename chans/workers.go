@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package chans
+
+import (
+	"context"
+	"sync"
+
+	"spheric.cloud/xstd/constraints"
+)
+
+// Group is a collection of goroutines working on subtasks of a common task.
+// The first subtask returning an error cancels the Group's context.
+// It is a standard library only counterpart to golang.org/x/sync/errgroup.
+type Group struct {
+	cancel context.CancelCauseFunc
+	wg     sync.WaitGroup
+
+	errOnce sync.Once
+	err     error
+}
+
+// NewGroup creates a new Group and a context derived from ctx.
+// The derived context is cancelled the first time a function passed to Go returns an error
+// or the first time Wait returns, whichever occurs first.
+func NewGroup(ctx context.Context) (*Group, context.Context) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	return &Group{cancel: cancel}, ctx
+}
+
+// Go calls f in a new goroutine.
+// The first call to return a non-nil error cancels the Group's context; its error is returned by Wait.
+func (g *Group) Go(f func() error) {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		if err := f(); err != nil {
+			g.errOnce.Do(func() {
+				g.err = err
+				g.cancel(err)
+			})
+		}
+	}()
+}
+
+// Wait blocks until all function calls from Go have returned, then returns the first non-nil error, if any.
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	g.cancel(g.err)
+	return g.err
+}
+
+// Workers starts n goroutines that call f for each value received from in and send the results to the
+// returned channel, in no particular order. The returned channel is closed once all workers have exited.
+//
+// The workers share a context derived from ctx. The first error returned by f cancels it, which makes all
+// workers exit. If ctx is done before in is closed, the workers exit with ctx.Err().
+// The returned function blocks until all workers have exited and returns the first error, if any.
+// The returned channel has to be drained or ctx be cancelled, otherwise the workers block sending results.
+func Workers[C constraints.Receive[In], In, Out any](ctx context.Context, n int, in C, f func(context.Context, In) (Out, error)) (<-chan Out, func() error) {
+	if n <= 0 {
+		panic("chans.Workers: n must be > 0")
+	}
+
+	var (
+		g, gCtx = NewGroup(ctx)
+		out     = make(chan Out)
+		done    = make(chan struct{})
+		err     error
+	)
+	for range n {
+		g.Go(func() error {
+			for {
+				select {
+				case <-gCtx.Done():
+					return ctx.Err()
+				case vIn, ok := <-in:
+					if !ok {
+						return nil
+					}
+
+					vOut, err := f(gCtx, vIn)
+					if err != nil {
+						return err
+					}
+					if err := Offer(gCtx, out, vOut); err != nil {
+						return ctx.Err()
+					}
+				}
+			}
+		})
+	}
+	go func() {
+		defer close(done)
+		err = g.Wait()
+		close(out)
+	}()
+
+	return out, func() error {
+		<-done
+		return err
+	}
+}
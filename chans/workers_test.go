@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package chans
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+)
+
+var errTest = errors.New("test error")
+
+func TestGroup(t *testing.T) {
+	g, ctx := NewGroup(context.Background())
+	g.Go(func() error { return nil })
+	g.Go(func() error { return errTest })
+	g.Go(func() error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if err := g.Wait(); err != errTest {
+		t.Errorf("Wait() = %v, want %v", err, errTest)
+	}
+	if ctx.Err() == nil {
+		t.Error("context not cancelled after Wait()")
+	}
+}
+
+func TestWorkers(t *testing.T) {
+	in := make(chan int)
+	go func() {
+		defer close(in)
+		SendSeq[int](in, slices.Values([]int{1, 2, 3, 4, 5}))
+	}()
+
+	out, wait := Workers(context.Background(), 3, in, func(ctx context.Context, v int) (int, error) {
+		return v * v, nil
+	})
+	got := slices.Sorted(RecvSeq(out))
+	if err := wait(); err != nil {
+		t.Errorf("wait() = %v, want nil", err)
+	}
+	if want := []int{1, 4, 9, 16, 25}; !slices.Equal(got, want) {
+		t.Errorf("Workers() = %v, want %v", got, want)
+	}
+}
+
+func TestWorkersError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	in := make(chan int)
+	go func() {
+		for i := 0; Offer(ctx, in, i) == nil; i++ {
+		}
+	}()
+
+	var active atomic.Int32
+	out, wait := Workers(context.Background(), 4, in, func(ctx context.Context, v int) (int, error) {
+		active.Add(1)
+		defer active.Add(-1)
+		if v == 10 {
+			return 0, errTest
+		}
+		return v, nil
+	})
+	for range out {
+	}
+	if err := wait(); err != errTest {
+		t.Errorf("wait() = %v, want %v", err, errTest)
+	}
+	if a := active.Load(); a != 0 {
+		t.Errorf("active workers after wait() = %d, want 0", a)
+	}
+}
+
+func TestWorkersCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan int)
+	out, wait := Workers(ctx, 2, in, func(ctx context.Context, v int) (int, error) {
+		return v, nil
+	})
+	cancel()
+	for range out {
+	}
+	if err := wait(); !errors.Is(err, context.Canceled) {
+		t.Errorf("wait() = %v, want %v", err, context.Canceled)
+	}
+}
@@ -17,3 +17,8 @@ type Send[T any] interface {
 type Receive[T any] interface {
 	~chan T | ~<-chan T
 }
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	Integer | Float
+}
@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package concurrentmap provides a map that is safe for concurrent use, sharded over multiple locks.
+package concurrentmap
+
+import (
+	"hash/maphash"
+	"iter"
+	"math/bits"
+	"runtime"
+	"sync"
+
+	"spheric.cloud/xstd/container/hashmap"
+)
+
+type shard[K, V any] struct {
+	mu sync.RWMutex
+	m  *hashmap.HashMap[K, V]
+	_  [32]byte // Pad to a cache line to avoid false sharing between shards
+}
+
+// ConcurrentMap is a map that is safe for concurrent use. Its entries are distributed over
+// a fixed number of shards by their hash, each guarded by its own lock, so operations on
+// keys in different shards do not contend.
+type ConcurrentMap[K, V any] struct {
+	hash   func(K) uint64
+	mask   uint64 // Mask of the mixed hash selecting the shard
+	shards []shard[K, V]
+}
+
+// DefaultShards returns the number of shards used by New and NewComparable.
+func DefaultShards() int {
+	return 4 * runtime.GOMAXPROCS(0)
+}
+
+// New constructs a new empty ConcurrentMap with DefaultShards shards, using the given hash and equality functions.
+// Keys that are equal have to have the same hash.
+func New[K, V any](hash func(K) uint64, equal func(k1, k2 K) bool) *ConcurrentMap[K, V] {
+	return NewSharded[K, V](DefaultShards(), hash, equal)
+}
+
+// NewSharded constructs a new empty ConcurrentMap with at least n shards, using the given hash and equality functions.
+// The number of shards is rounded up to a power of two.
+func NewSharded[K, V any](n int, hash func(K) uint64, equal func(k1, k2 K) bool) *ConcurrentMap[K, V] {
+	if n <= 0 {
+		panic("concurrentmap.NewSharded: n must be > 0")
+	}
+	shardBits := uint(bits.Len(uint(n - 1)))
+	m := &ConcurrentMap[K, V]{
+		hash:   hash,
+		mask:   1<<shardBits - 1,
+		shards: make([]shard[K, V], 1<<shardBits),
+	}
+	for i := range m.shards {
+		m.shards[i].m = hashmap.New[K, V](hash, equal)
+	}
+	return m
+}
+
+var seed = maphash.MakeSeed()
+
+// NewComparable constructs a new empty ConcurrentMap for comparable keys with DefaultShards shards, using maphash and ==.
+func NewComparable[K comparable, V any]() *ConcurrentMap[K, V] {
+	return New[K, V](func(k K) uint64 { return maphash.Comparable(seed, k) }, func(k1 K, k2 K) bool { return k1 == k2 })
+}
+
+// mix is the finalizer of MurmurHash3, which makes every bit of h affect every bit of the result.
+func mix(h uint64) uint64 {
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
+
+func (m *ConcurrentMap[K, V]) shardFor(key K) *shard[K, V] {
+	if len(m.shards) == 1 {
+		return &m.shards[0]
+	}
+	// The hash map of each shard picks slots by Fibonacci hashing the unmixed hash, so the shard is
+	// picked using a different mixing function to keep the two independent.
+	return &m.shards[mix(m.hash(key))&m.mask]
+}
+
+// Load returns the value stored for key, if any.
+func (m *ConcurrentMap[K, V]) Load(key K) (V, bool) {
+	s := m.shardFor(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Get(key)
+}
+
+// Store stores value for key, replacing any previous value.
+func (m *ConcurrentMap[K, V]) Store(key K, value V) {
+	s := m.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.Put(key, value)
+}
+
+// LoadOrStore returns the value stored for key, if any. Otherwise, it stores and returns value.
+// The loaded result is true if the value was already present.
+func (m *ConcurrentMap[K, V]) LoadOrStore(key K, value V) (actual V, loaded bool) {
+	s := m.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.GetOrPut(key, value)
+}
+
+// LoadAndDelete removes the entry for key, returning its value, if any.
+func (m *ConcurrentMap[K, V]) LoadAndDelete(key K) (V, bool) {
+	s := m.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.m.Get(key)
+	if ok {
+		s.m.Delete(key)
+	}
+	return v, ok
+}
+
+// Delete removes the entry for key and reports whether it was present.
+func (m *ConcurrentMap[K, V]) Delete(key K) bool {
+	s := m.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.Delete(key)
+}
+
+// Compute atomically updates the entry for key using f, which receives the current value and whether it is present.
+// If f returns keep as true, its result is stored for key; otherwise, the entry for key is removed.
+// Compute returns the new value and whether key is present afterward.
+// f is called with the lock of the key's shard held, so it must not access the map.
+func (m *ConcurrentMap[K, V]) Compute(key K, f func(value V, ok bool) (newValue V, keep bool)) (V, bool) {
+	s := m.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.Compute(key, f)
+}
+
+// Len returns the number of entries in the map.
+// The shards are counted one after another, so concurrent modifications may or may not be reflected.
+func (m *ConcurrentMap[K, V]) Len() int {
+	var n int
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.mu.RLock()
+		n += s.m.Len()
+		s.mu.RUnlock()
+	}
+	return n
+}
+
+// Clear removes all entries from the map.
+// The shards are cleared one after another, so entries stored concurrently may remain.
+func (m *ConcurrentMap[K, V]) Clear() {
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.mu.Lock()
+		s.m.Clear()
+		s.mu.Unlock()
+	}
+}
+
+type kv[K, V any] struct {
+	k K
+	v V
+}
+
+// Range returns an iterator over the entries of the map, in unspecified order.
+//
+// Each shard is snapshotted under its lock right before its entries are yielded, and no lock is held
+// while yielding, so the map may be modified during iteration. The entries of a single shard are
+// consistent with each other, but the iteration as a whole is not a consistent snapshot of the map:
+// an entry stored or deleted concurrently may or may not be yielded.
+func (m *ConcurrentMap[K, V]) Range() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var snapshot []kv[K, V]
+		for i := range m.shards {
+			s := &m.shards[i]
+			snapshot = snapshot[:0]
+			s.mu.RLock()
+			for k, v := range s.m.All() {
+				snapshot = append(snapshot, kv[K, V]{k, v})
+			}
+			s.mu.RUnlock()
+
+			for _, e := range snapshot {
+				if !yield(e.k, e.v) {
+					return
+				}
+			}
+		}
+	}
+}
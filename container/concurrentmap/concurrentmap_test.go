@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package concurrentmap
+
+import (
+	"maps"
+	"sync"
+	"testing"
+)
+
+func TestConcurrentMap(t *testing.T) {
+	m := NewComparable[string, int]()
+	m.Store("a", 1)
+	if v, ok := m.Load("a"); !ok || v != 1 {
+		t.Errorf("Load() = %d, %v, want 1, true", v, ok)
+	}
+
+	if v, loaded := m.LoadOrStore("a", 2); !loaded || v != 1 {
+		t.Errorf("LoadOrStore() = %d, %v, want 1, true", v, loaded)
+	}
+	if v, loaded := m.LoadOrStore("b", 2); loaded || v != 2 {
+		t.Errorf("LoadOrStore() = %d, %v, want 2, false", v, loaded)
+	}
+
+	if v, ok := m.LoadAndDelete("a"); !ok || v != 1 {
+		t.Errorf("LoadAndDelete() = %d, %v, want 1, true", v, ok)
+	}
+	if _, ok := m.LoadAndDelete("a"); ok {
+		t.Error("LoadAndDelete() of missing key should return false")
+	}
+	if !m.Delete("b") || m.Len() != 0 {
+		t.Errorf("Delete() failed, Len() = %d", m.Len())
+	}
+}
+
+func TestConcurrentMapCustom(t *testing.T) {
+	// A plain identity hash over small integers has to be spread over all shards.
+	m := NewSharded[[2]int, string](3,
+		func(k [2]int) uint64 { return uint64(k[0]) },
+		func(k1, k2 [2]int) bool { return k1 == k2 },
+	)
+	if len(m.shards) != 4 {
+		t.Errorf("len(shards) = %d, want 4", len(m.shards))
+	}
+
+	const n = 1024
+	want := make(map[[2]int]string)
+	for i := 0; i < n; i++ {
+		k := [2]int{i, -i}
+		m.Store(k, "v")
+		want[k] = "v"
+	}
+	if got := maps.Collect(m.Range()); !maps.Equal(got, want) {
+		t.Errorf("Range() = %v, want %v", got, want)
+	}
+	for i := range m.shards {
+		if got := m.shards[i].m.Len(); got < n/len(m.shards)/2 {
+			t.Errorf("shard %d holds %d entries, want at least %d", i, got, n/len(m.shards)/2)
+		}
+	}
+
+	m.Clear()
+	if m.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", m.Len())
+	}
+}
+
+func TestConcurrentMapCompute(t *testing.T) {
+	m := NewComparable[int, int]()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				m.Compute(j%10, func(v int, _ bool) (int, bool) { return v + 1, true })
+				for range m.Range() {
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	for k, v := range m.Range() {
+		if v != 800 {
+			t.Errorf("entry %d = %d, want 800", k, v)
+		}
+	}
+	if m.Len() != 10 {
+		t.Errorf("Len() = %d, want 10", m.Len())
+	}
+
+	if _, ok := m.Compute(0, func(int, bool) (int, bool) { return 0, false }); ok {
+		t.Error("Compute() removing the entry should return false")
+	}
+	if _, ok := m.Load(0); ok {
+		t.Error("Load() after removal should return false")
+	}
+}
+
+func BenchmarkStore(b *testing.B) {
+	b.Run("ConcurrentMap", func(b *testing.B) {
+		m := NewComparable[int, int]()
+		b.RunParallel(func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				m.Store(i%4096, i)
+			}
+		})
+	})
+	b.Run("sync.Map", func(b *testing.B) {
+		var m sync.Map
+		b.RunParallel(func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				m.Store(i%4096, i)
+			}
+		})
+	})
+}
+
+func BenchmarkLoad(b *testing.B) {
+	cm := NewComparable[int, int]()
+	var sm sync.Map
+	for i := 0; i < 4096; i++ {
+		cm.Store(i, i)
+		sm.Store(i, i)
+	}
+
+	b.Run("ConcurrentMap", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				cm.Load(i % 4096)
+			}
+		})
+	})
+	b.Run("sync.Map", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				sm.Load(i % 4096)
+			}
+		})
+	})
+}
@@ -1,136 +1,281 @@
 // SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
 // SPDX-License-Identifier: Apache-2.0
 
+// Package hashmap provides a hash map with user-defined hash and equality functions.
 package hashmap
 
 import (
 	"hash/maphash"
 	"iter"
-	"slices"
+	"math/bits"
 )
 
-type entry[K, V any] struct {
+const (
+	minCapacity = 8
+
+	// The table grows once it is more than maxLoadNum/maxLoadDen full.
+	maxLoadNum = 7
+	maxLoadDen = 8
+
+	// fibonacci is 2^64 divided by the golden ratio, used to mix hashes before they are reduced to an index.
+	fibonacci = 0x9e3779b97f4a7c15
+)
+
+// slot is a slot of the table. dist is one more than the distance of the entry from its
+// home slot, so that the zero slot is empty.
+type slot[K, V any] struct {
 	key   K
 	value V
+	hash  uint64
+	dist  uint32
 }
 
+// HashMap is a hash map using open addressing with Robin Hood hashing and linear probing.
+// Entries are stored inline in a single slice, and deletions use backward shifting instead of tombstones.
+// Hashes are mixed using Fibonacci hashing, so all of their bits affect the slot an entry is placed in.
 type HashMap[K, V any] struct {
-	hash    func(K) uint64
-	equal   func(K, K) bool
-	len     int
-	entries map[uint64][]*entry[K, V]
+	hash  func(K) uint64
+	equal func(K, K) bool
+	len   int
+	shift uint // 64 minus the base-2 logarithm of len(slots)
+	slots []slot[K, V]
 }
 
+// New constructs a new empty HashMap using the given hash and equality functions.
+// Keys that are equal have to have the same hash.
 func New[K, V any](hash func(K) uint64, equal func(k1, k2 K) bool) *HashMap[K, V] {
 	return &HashMap[K, V]{
-		hash:    hash,
-		equal:   equal,
-		entries: make(map[uint64][]*entry[K, V]),
+		hash:  hash,
+		equal: equal,
 	}
 }
 
 var seed = maphash.MakeSeed()
 
+// NewComparable constructs a new empty HashMap for comparable keys, using maphash and ==.
 func NewComparable[K comparable, V any]() *HashMap[K, V] {
 	return New[K, V](func(k K) uint64 { return maphash.Comparable(seed, k) }, func(k1 K, k2 K) bool { return k1 == k2 })
 }
 
-func (h *HashMap[K, V]) findEntryIndex(entries []*entry[K, V], key K) int {
-	return slices.IndexFunc(entries, func(e *entry[K, V]) bool { return h.equal(e.key, key) })
+func (h *HashMap[K, V]) mask() uint64 {
+	return uint64(len(h.slots) - 1)
+}
+
+// home returns the index of the slot an entry with the given hash is ideally placed in.
+func (h *HashMap[K, V]) home(hash uint64) uint64 {
+	return (hash * fibonacci) >> h.shift
+}
+
+// find returns the index of the slot holding key, or -1 if there is none.
+func (h *HashMap[K, V]) find(hash uint64, key K) int {
+	if len(h.slots) == 0 {
+		return -1
+	}
+	mask := h.mask()
+	for i, dist := h.home(hash), uint32(1); ; i, dist = (i+1)&mask, dist+1 {
+		s := &h.slots[i]
+		// Robin Hood invariant: if the key were present, it would have been placed here at the latest.
+		if s.dist < dist {
+			return -1
+		}
+		if s.hash == hash && h.equal(s.key, key) {
+			return int(i)
+		}
+	}
+}
+
+// insert inserts an entry for a key that is not present. The table has to have room for it.
+func (h *HashMap[K, V]) insert(hash uint64, key K, value V) {
+	var (
+		mask = h.mask()
+		cur  = slot[K, V]{key: key, value: value, hash: hash, dist: 1}
+	)
+	for i := h.home(hash); ; i = (i + 1) & mask {
+		s := &h.slots[i]
+		if s.dist == 0 {
+			*s = cur
+			h.len++
+			return
+		}
+		// Take the slot from entries closer to their home slot than cur.
+		if s.dist < cur.dist {
+			*s, cur = cur, *s
+		}
+		cur.dist++
+	}
+}
+
+// deleteAt removes the entry at index i, shifting subsequent entries back.
+func (h *HashMap[K, V]) deleteAt(i int) {
+	mask := h.mask()
+	for {
+		j := (uint64(i) + 1) & mask
+		if h.slots[j].dist <= 1 {
+			h.slots[i] = slot[K, V]{}
+			break
+		}
+		h.slots[i] = h.slots[j]
+		h.slots[i].dist--
+		i = int(j)
+	}
+	h.len--
+}
+
+func capacityFor(n int) int {
+	// Smallest power of two holding n entries without exceeding the maximum load.
+	need := (n*maxLoadDen + maxLoadNum - 1) / maxLoadNum
+	if need <= minCapacity {
+		return minCapacity
+	}
+	return 1 << bits.Len(uint(need-1))
+}
+
+func (h *HashMap[K, V]) resize(capacity int) {
+	old := h.slots
+	h.slots = make([]slot[K, V], capacity)
+	h.shift = uint(64 - bits.TrailingZeros(uint(capacity)))
+	h.len = 0
+	for i := range old {
+		if s := &old[i]; s.dist != 0 {
+			h.insert(s.hash, s.key, s.value)
+		}
+	}
 }
 
-func (h *HashMap[K, V]) findEntry(entries []*entry[K, V], key K) *entry[K, V] {
-	idx := h.findEntryIndex(entries, key)
-	if idx == -1 {
-		return nil
+// Grow grows the map's capacity, if necessary, to guarantee space for another n entries.
+// After Grow(n), at least n entries can be put into the map without another allocation.
+// If n is negative, Grow panics.
+func (h *HashMap[K, V]) Grow(n int) {
+	if n < 0 {
+		panic("hashmap.HashMap.Grow: negative n")
+	}
+	if c := capacityFor(h.len + n); c > len(h.slots) {
+		h.resize(c)
 	}
-	return entries[idx]
 }
 
+// Put stores value for key, replacing any previous value.
 func (h *HashMap[K, V]) Put(key K, value V) {
 	hash := h.hash(key)
-	entries := h.entries[hash]
-	e := h.findEntry(entries, key)
-	if e == nil {
-		h.entries[hash] = append(entries, &entry[K, V]{key, value})
-		h.len++
-	} else {
-		e.value = value
+	if i := h.find(hash, key); i >= 0 {
+		h.slots[i].value = value
+		return
 	}
+	h.Grow(1)
+	h.insert(hash, key, value)
 }
 
+// Get returns the value stored for key, if any.
 func (h *HashMap[K, V]) Get(key K) (V, bool) {
-	hash := h.hash(key)
-	entries, ok := h.entries[hash]
-	if !ok {
+	i := h.find(h.hash(key), key)
+	if i < 0 {
 		var zero V
 		return zero, false
 	}
+	return h.slots[i].value, true
+}
 
-	e := h.findEntry(entries, key)
-	if e == nil {
-		var zero V
-		return zero, false
+// GetOrPut returns the value stored for key, if any. Otherwise, it stores and returns value.
+// The loaded result is true if the value was already present.
+func (h *HashMap[K, V]) GetOrPut(key K, value V) (actual V, loaded bool) {
+	hash := h.hash(key)
+	if i := h.find(hash, key); i >= 0 {
+		return h.slots[i].value, true
 	}
-	return e.value, true
+	h.Grow(1)
+	h.insert(hash, key, value)
+	return value, false
 }
 
-func (h *HashMap[K, V]) Delete(key K) bool {
+// Compute updates the entry for key using f, which receives the current value and whether it is present.
+// If f returns keep as true, its result is stored for key; otherwise, the entry for key is removed.
+// Compute returns the new value and whether key is present afterward. f must not modify the map.
+func (h *HashMap[K, V]) Compute(key K, f func(value V, ok bool) (newValue V, keep bool)) (V, bool) {
 	hash := h.hash(key)
-	entries, ok := h.entries[hash]
-	if !ok {
-		return false
+	if i := h.find(hash, key); i >= 0 {
+		v, keep := f(h.slots[i].value, true)
+		if !keep {
+			h.deleteAt(i)
+			var zero V
+			return zero, false
+		}
+		h.slots[i].value = v
+		return v, true
 	}
 
-	idx := h.findEntryIndex(entries, key)
-	if idx < 0 {
-		return false
+	var zero V
+	v, keep := f(zero, false)
+	if !keep {
+		return zero, false
 	}
+	h.Grow(1)
+	h.insert(hash, key, v)
+	return v, true
+}
 
-	h.entries[hash] = slices.Delete(entries, idx, idx+1)
-	h.len--
+// Delete removes the entry for key and reports whether it was present.
+func (h *HashMap[K, V]) Delete(key K) bool {
+	i := h.find(h.hash(key), key)
+	if i < 0 {
+		return false
+	}
+	h.deleteAt(i)
 	return true
 }
 
+// Len returns the number of entries in the map.
 func (h *HashMap[K, V]) Len() int {
 	return h.len
 }
 
+// Clear removes all entries from the map, retaining its capacity.
 func (h *HashMap[K, V]) Clear() {
-	clear(h.entries)
+	clear(h.slots)
+	h.len = 0
+}
+
+// Clone returns a copy of the map using the same hash and equality functions.
+// The entries are copied shallowly.
+func (h *HashMap[K, V]) Clone() *HashMap[K, V] {
+	res := *h
+	if h.slots != nil {
+		res.slots = make([]slot[K, V], len(h.slots))
+		copy(res.slots, h.slots)
+	}
+	return &res
 }
 
+// All returns an iterator over the entries of the map, in unspecified order.
+// The map must not be modified during iteration.
 func (h *HashMap[K, V]) All() iter.Seq2[K, V] {
 	return func(yield func(K, V) bool) {
-		for _, es := range h.entries {
-			for _, e := range es {
-				if !yield(e.key, e.value) {
-					return
-				}
+		for i := range h.slots {
+			if s := &h.slots[i]; s.dist != 0 && !yield(s.key, s.value) {
+				return
 			}
 		}
 	}
 }
 
+// Keys returns an iterator over the keys of the map, in unspecified order.
+// The map must not be modified during iteration.
 func (h *HashMap[K, V]) Keys() iter.Seq[K] {
 	return func(yield func(K) bool) {
-		for _, es := range h.entries {
-			for _, e := range es {
-				if !yield(e.key) {
-					return
-				}
+		for i := range h.slots {
+			if s := &h.slots[i]; s.dist != 0 && !yield(s.key) {
+				return
 			}
 		}
 	}
 }
 
+// Values returns an iterator over the values of the map, in unspecified order.
+// The map must not be modified during iteration.
 func (h *HashMap[K, V]) Values() iter.Seq[V] {
 	return func(yield func(V) bool) {
-		for _, es := range h.entries {
-			for _, e := range es {
-				if !yield(e.value) {
-					return
-				}
+		for i := range h.slots {
+			if s := &h.slots[i]; s.dist != 0 && !yield(s.value) {
+				return
 			}
 		}
 	}
@@ -0,0 +1,261 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package hashmap
+
+import (
+	"maps"
+	"math/rand/v2"
+	"strconv"
+	"testing"
+)
+
+type point struct {
+	x, y int
+}
+
+// newPointMap returns a map with a deliberately poor hash, to exercise collisions.
+func newPointMap[V any]() *HashMap[point, V] {
+	return New[point, V](
+		func(p point) uint64 { return uint64(p.x % 4) },
+		func(p1, p2 point) bool { return p1 == p2 },
+	)
+}
+
+func TestHashMapRandom(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for name, h := range map[string]*HashMap[point, int]{
+		"comparable": NewComparable[point, int](),
+		"collisions": newPointMap[int](),
+	} {
+		t.Run(name, func(t *testing.T) {
+			want := make(map[point]int)
+			for i := 0; i < 5000; i++ {
+				k := point{r.IntN(100), r.IntN(10)}
+				switch r.IntN(3) {
+				case 0:
+					_, ok := want[k]
+					if got := h.Delete(k); got != ok {
+						t.Fatalf("Delete(%v) = %v, want %v", k, got, ok)
+					}
+					delete(want, k)
+				default:
+					h.Put(k, i)
+					want[k] = i
+				}
+			}
+
+			if h.Len() != len(want) {
+				t.Errorf("Len() = %d, want %d", h.Len(), len(want))
+			}
+			if got := maps.Collect(h.All()); !maps.Equal(got, want) {
+				t.Errorf("All() = %v, want %v", got, want)
+			}
+			for k, v := range want {
+				if got, ok := h.Get(k); !ok || got != v {
+					t.Errorf("Get(%v) = %d, %v, want %d, true", k, got, ok, v)
+				}
+			}
+			if _, ok := h.Get(point{-1, -1}); ok {
+				t.Error("Get() of missing key should return false")
+			}
+		})
+	}
+}
+
+func TestHashMapHighBitsHash(t *testing.T) {
+	// A hash that only varies in its high bits must not put all keys into one probe chain.
+	h := New[int, int](func(k int) uint64 { return uint64(k) << 32 }, func(k1, k2 int) bool { return k1 == k2 })
+	const n = 1 << 14
+	for k := 0; k < n; k++ {
+		h.Put(k, k)
+	}
+
+	var maxDist uint32
+	for _, s := range h.slots {
+		maxDist = max(maxDist, s.dist)
+	}
+	if maxDist > 64 {
+		t.Errorf("maximum probe distance = %d, want <= 64", maxDist)
+	}
+	for k := 0; k < n; k++ {
+		if v, ok := h.Get(k); !ok || v != k {
+			t.Fatalf("Get(%d) = %d, %v, want %d, true", k, v, ok, k)
+		}
+	}
+}
+
+func TestHashMapClear(t *testing.T) {
+	h := NewComparable[int, int]()
+	for i := 0; i < 100; i++ {
+		h.Put(i, i)
+	}
+	h.Clear()
+	if h.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", h.Len())
+	}
+	if _, ok := h.Get(1); ok {
+		t.Error("Get() after Clear() should return false")
+	}
+	for range h.All() {
+		t.Fatal("All() after Clear() should be empty")
+	}
+}
+
+func TestHashMapGrow(t *testing.T) {
+	h := NewComparable[int, int]()
+	h.Grow(1000)
+	slots := len(h.slots)
+	for i := 0; i < 1000; i++ {
+		h.Put(i, i)
+	}
+	if len(h.slots) != slots {
+		t.Errorf("Put() after Grow() resized the map from %d to %d slots", slots, len(h.slots))
+	}
+}
+
+func TestHashMapGetOrPut(t *testing.T) {
+	h := NewComparable[string, int]()
+	if v, loaded := h.GetOrPut("a", 1); loaded || v != 1 {
+		t.Errorf("GetOrPut() = %d, %v, want 1, false", v, loaded)
+	}
+	if v, loaded := h.GetOrPut("a", 2); !loaded || v != 1 {
+		t.Errorf("GetOrPut() = %d, %v, want 1, true", v, loaded)
+	}
+}
+
+func TestHashMapCompute(t *testing.T) {
+	h := NewComparable[string, int]()
+	incr := func(v int, _ bool) (int, bool) { return v + 1, true }
+
+	h.Compute("a", incr)
+	if v, ok := h.Compute("a", incr); !ok || v != 2 {
+		t.Errorf("Compute() = %d, %v, want 2, true", v, ok)
+	}
+	if _, ok := h.Compute("a", func(int, bool) (int, bool) { return 0, false }); ok {
+		t.Error("Compute() removing the entry should return false")
+	}
+	if _, ok := h.Get("a"); ok || h.Len() != 0 {
+		t.Errorf("Get() = _, %v, Len() = %d, want false, 0", ok, h.Len())
+	}
+	if _, ok := h.Compute("b", func(int, bool) (int, bool) { return 0, false }); ok || h.Len() != 0 {
+		t.Error("Compute() without keeping should not add an entry")
+	}
+}
+
+func TestHashMapClone(t *testing.T) {
+	h := newPointMap[string]()
+	h.Put(point{1, 2}, "a")
+	c := h.Clone()
+	c.Put(point{1, 2}, "b")
+	c.Put(point{3, 4}, "c")
+
+	if v, _ := h.Get(point{1, 2}); v != "a" || h.Len() != 1 {
+		t.Errorf("original modified by clone: %q, %d", v, h.Len())
+	}
+	if c.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", c.Len())
+	}
+	if New[int, int](nil, nil).Clone().Len() != 0 {
+		t.Error("Clone() of empty map should be empty")
+	}
+}
+
+const benchSize = 1 << 12
+
+func BenchmarkPut(b *testing.B) {
+	b.Run("HashMap", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			h := NewComparable[int, int]()
+			for k := 0; k < benchSize; k++ {
+				h.Put(k, k)
+			}
+		}
+	})
+	b.Run("map", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			m := make(map[int]int)
+			for k := 0; k < benchSize; k++ {
+				m[k] = k
+			}
+		}
+	})
+}
+
+func BenchmarkGet(b *testing.B) {
+	h := NewComparable[int, int]()
+	m := make(map[int]int)
+	for k := 0; k < benchSize; k++ {
+		h.Put(k, k)
+		m[k] = k
+	}
+
+	b.Run("HashMap", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = h.Get(i % benchSize)
+		}
+	})
+	b.Run("map", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = m[i%benchSize]
+		}
+	})
+}
+
+func BenchmarkStructKeys(b *testing.B) {
+	keys := make([]point, benchSize)
+	for i := range keys {
+		keys[i] = point{i, i * 31}
+	}
+	h := New[point, int](
+		func(p point) uint64 { return uint64(p.x)*0x9e3779b97f4a7c15 ^ uint64(p.y) },
+		func(p1, p2 point) bool { return p1 == p2 },
+	)
+	m := make(map[point]int)
+	for i, k := range keys {
+		h.Put(k, i)
+		m[k] = i
+	}
+
+	b.Run("HashMap", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = h.Get(keys[i%benchSize])
+		}
+	})
+	b.Run("map", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = m[keys[i%benchSize]]
+		}
+	})
+}
+
+func BenchmarkDelete(b *testing.B) {
+	keys := make([]string, benchSize)
+	for i := range keys {
+		keys[i] = strconv.Itoa(i)
+	}
+
+	b.Run("HashMap", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			h := NewComparable[string, int]()
+			h.Grow(benchSize)
+			for _, k := range keys {
+				h.Put(k, 0)
+			}
+			for _, k := range keys {
+				h.Delete(k)
+			}
+		}
+	})
+	b.Run("map", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			m := make(map[string]int, benchSize)
+			for _, k := range keys {
+				m[k] = 0
+			}
+			for _, k := range keys {
+				delete(m, k)
+			}
+		}
+	})
+}
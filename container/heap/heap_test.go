@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package heap
+
+import (
+	"slices"
+	"testing"
+)
+
+type intHeap []int
+
+func (h intHeap) Len() int           { return len(h) }
+func (h intHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h intHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *intHeap) Push(x int)        { *h = append(*h, x) }
+func (h *intHeap) Pop() int {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+func (h intHeap) verify(t *testing.T, i int) {
+	t.Helper()
+	n := h.Len()
+	for _, j := range []int{2*i + 1, 2*i + 2} {
+		if j < n {
+			if h.Less(j, i) {
+				t.Errorf("heap invariant invalidated [%d] = %d > [%d] = %d", i, h[i], j, h[j])
+				return
+			}
+			h.verify(t, j)
+		}
+	}
+}
+
+func TestHeap(t *testing.T) {
+	h := &intHeap{5, 2, 8, 1, 9, 3}
+	Init[int](h)
+	h.verify(t, 0)
+
+	Push[int](h, 0)
+	Push[int](h, 7)
+	h.verify(t, 0)
+
+	(*h)[3] = -1
+	Fix[int](h, 3)
+	h.verify(t, 0)
+
+	if v := Remove[int](h, 2); v < 0 {
+		t.Errorf("Remove() = %d, want >= 0", v)
+	}
+	h.verify(t, 0)
+
+	var got []int
+	for h.Len() > 0 {
+		got = append(got, Pop[int](h))
+	}
+	if !slices.IsSorted(got) {
+		t.Errorf("Pop() order = %v, want sorted", got)
+	}
+	if got[0] != -1 {
+		t.Errorf("first Pop() = %d, want -1", got[0])
+	}
+}
@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package heap
+
+import (
+	"iter"
+)
+
+// Item is a handle to an element of a PriorityQueue.
+type Item[E any] struct {
+	// Value is the value of the Item.
+	// After changing it, PriorityQueue.Fix has to be called to restore the ordering.
+	Value E
+
+	pq    *PriorityQueue[E]
+	index int
+}
+
+// PriorityQueue is a min-heap of elements ordered by a comparison function:
+// the element for which compare reports the smallest value is retrieved first.
+type PriorityQueue[E any] struct {
+	items   []*Item[E]
+	compare func(E, E) int
+}
+
+// New constructs a new empty PriorityQueue ordered by compare.
+func New[E any](compare func(E, E) int) *PriorityQueue[E] {
+	return &PriorityQueue[E]{compare: compare}
+}
+
+// Heapify constructs a new PriorityQueue ordered by compare containing the elements of s.
+// The complexity is O(n) where n = len(s).
+func Heapify[E any](compare func(E, E) int, s []E) *PriorityQueue[E] {
+	pq := &PriorityQueue[E]{
+		items:   make([]*Item[E], len(s)),
+		compare: compare,
+	}
+	for i, e := range s {
+		pq.items[i] = &Item[E]{Value: e, pq: pq, index: i}
+	}
+	Init(queue[E]{pq})
+	return pq
+}
+
+// queue implements Interface for a PriorityQueue, keeping the indices of its items up to date.
+type queue[E any] struct {
+	pq *PriorityQueue[E]
+}
+
+func (q queue[E]) Len() int { return len(q.pq.items) }
+func (q queue[E]) Less(i, j int) bool {
+	return q.pq.compare(q.pq.items[i].Value, q.pq.items[j].Value) < 0
+}
+func (q queue[E]) Swap(i, j int) {
+	items := q.pq.items
+	items[i], items[j] = items[j], items[i]
+	items[i].index = i
+	items[j].index = j
+}
+func (q queue[E]) Push(x *Item[E]) {
+	x.index = len(q.pq.items)
+	q.pq.items = append(q.pq.items, x)
+}
+func (q queue[E]) Pop() *Item[E] {
+	n := len(q.pq.items)
+	item := q.pq.items[n-1]
+	q.pq.items[n-1] = nil // Zero the value to help GC
+	q.pq.items = q.pq.items[:n-1]
+	item.pq = nil
+	item.index = -1
+	return item
+}
+
+// Len returns the number of elements in the queue.
+func (pq *PriorityQueue[E]) Len() int {
+	return len(pq.items)
+}
+
+// Push adds e to the queue and returns a handle to it.
+func (pq *PriorityQueue[E]) Push(e E) *Item[E] {
+	item := &Item[E]{Value: e, pq: pq}
+	Push(queue[E]{pq}, item)
+	return item
+}
+
+// Pop removes and returns the smallest element of the queue.
+func (pq *PriorityQueue[E]) Pop() (E, bool) {
+	if len(pq.items) == 0 {
+		var zero E
+		return zero, false
+	}
+	return Pop(queue[E]{pq}).Value, true
+}
+
+// Peek returns the smallest element of the queue without removing it.
+func (pq *PriorityQueue[E]) Peek() (E, bool) {
+	if len(pq.items) == 0 {
+		var zero E
+		return zero, false
+	}
+	return pq.items[0].Value, true
+}
+
+// contains reports whether item is an element of the queue.
+func (pq *PriorityQueue[E]) contains(item *Item[E]) bool {
+	return item.pq == pq
+}
+
+// Fix restores the ordering of the queue after the Value of item has changed.
+// It is a no-op if item is not an element of the queue.
+func (pq *PriorityQueue[E]) Fix(item *Item[E]) {
+	if pq.contains(item) {
+		Fix(queue[E]{pq}, item.index)
+	}
+}
+
+// Update sets the Value of item to e and restores the ordering of the queue.
+// It is a no-op if item is not an element of the queue.
+func (pq *PriorityQueue[E]) Update(item *Item[E], e E) {
+	if pq.contains(item) {
+		item.Value = e
+		Fix(queue[E]{pq}, item.index)
+	}
+}
+
+// Remove removes item from the queue and returns its value.
+// It reports false if item is not an element of the queue.
+func (pq *PriorityQueue[E]) Remove(item *Item[E]) (E, bool) {
+	if !pq.contains(item) {
+		var zero E
+		return zero, false
+	}
+	return Remove(queue[E]{pq}, item.index).Value, true
+}
+
+// Drain returns an iterator that pops the elements of the queue in priority order.
+// Elements that have been yielded are removed from the queue, even if iteration stops early.
+func (pq *PriorityQueue[E]) Drain() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for len(pq.items) > 0 {
+			if !yield(Pop(queue[E]{pq}).Value) {
+				return
+			}
+		}
+	}
+}
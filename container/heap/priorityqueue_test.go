@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package heap
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	pq := New(cmp.Compare[int])
+	if _, ok := pq.Pop(); ok {
+		t.Error("Pop() on empty queue should return false")
+	}
+
+	for _, v := range []int{5, 2, 8, 1} {
+		pq.Push(v)
+	}
+	if v, ok := pq.Peek(); !ok || v != 1 {
+		t.Errorf("Peek() = %d, %v, want 1, true", v, ok)
+	}
+	if v, ok := pq.Pop(); !ok || v != 1 {
+		t.Errorf("Pop() = %d, %v, want 1, true", v, ok)
+	}
+	if pq.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", pq.Len())
+	}
+	if got := slices.Collect(pq.Drain()); !slices.Equal(got, []int{2, 5, 8}) {
+		t.Errorf("Drain() = %v, want [2 5 8]", got)
+	}
+}
+
+func TestPriorityQueueHandles(t *testing.T) {
+	pq := New(cmp.Compare[int])
+	a := pq.Push(10)
+	b := pq.Push(20)
+	c := pq.Push(30)
+
+	pq.Update(c, 5)
+	if v, _ := pq.Peek(); v != 5 {
+		t.Errorf("Peek() = %d, want 5", v)
+	}
+
+	a.Value = 40
+	pq.Fix(a)
+	if v, ok := pq.Remove(b); !ok || v != 20 {
+		t.Errorf("Remove() = %d, %v, want 20, true", v, ok)
+	}
+	if _, ok := pq.Remove(b); ok {
+		t.Error("Remove() of removed item should return false")
+	}
+	pq.Update(b, 0)
+	if got := slices.Collect(pq.Drain()); !slices.Equal(got, []int{5, 40}) {
+		t.Errorf("Drain() = %v, want [5 40]", got)
+	}
+
+	if _, ok := New(cmp.Compare[int]).Remove(a); ok {
+		t.Error("Remove() of foreign item should return false")
+	}
+}
+
+func TestHeapify(t *testing.T) {
+	s := []int{9, 4, 7, 1, 8, 2}
+	pq := Heapify(cmp.Compare[int], s)
+	for v := range pq.Drain() {
+		if v > 4 {
+			break
+		}
+	}
+	if got := slices.Collect(pq.Drain()); !slices.Equal(got, []int{8, 9}) {
+		t.Errorf("Drain() = %v, want [8 9]", got)
+	}
+}
@@ -107,6 +107,9 @@ func (l *List[E]) move(e, at *Element[E]) {
 	}
 	e.prev.next = e.next
 	e.next.prev = e.prev
+
+	e.prev = at
+	e.next = at.next
 	e.prev.next = e
 	e.next.prev = e
 }
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func values[E any](l *List[E]) []E {
+	var res []E
+	for e := l.Front(); e != nil; e = e.Next() {
+		res = append(res, e.Value)
+	}
+	return res
+}
+
+func TestListMove(t *testing.T) {
+	l := New[int]()
+	e1 := l.PushBack(1)
+	e2 := l.PushBack(2)
+	e3 := l.PushBack(3)
+
+	l.MoveToFront(e3)
+	if got := values(l); !slices.Equal(got, []int{3, 1, 2}) {
+		t.Errorf("MoveToFront() = %v, want [3 1 2]", got)
+	}
+	l.MoveToBack(e3)
+	if got := values(l); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("MoveToBack() = %v, want [1 2 3]", got)
+	}
+	l.MoveBefore(e3, e1)
+	if got := values(l); !slices.Equal(got, []int{3, 1, 2}) {
+		t.Errorf("MoveBefore() = %v, want [3 1 2]", got)
+	}
+	l.MoveAfter(e3, e2)
+	if got := values(l); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("MoveAfter() = %v, want [1 2 3]", got)
+	}
+	if l.Back() != e3 || e3.Prev() != e2 {
+		t.Error("links not updated after move")
+	}
+}
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package lru provides least-recently-used caches built on container/list.
+package lru
+
+import (
+	"iter"
+
+	"spheric.cloud/xstd/container/list"
+)
+
+// Cache is the interface implemented by LRU and TTL.
+type Cache[K comparable, V any] interface {
+	Put(key K, value V)
+	Get(key K) (V, bool)
+	Peek(key K) (V, bool)
+	Delete(key K) bool
+	Len() int
+	Clear()
+	All() iter.Seq2[K, V]
+}
+
+type entry[K, V any] struct {
+	key   K
+	value V
+	cost  int
+}
+
+// LRU is a cache that evicts the least recently used entries once the total cost of its entries exceeds its capacity.
+// By default, every entry has a cost of one, so the capacity is the maximum number of entries.
+type LRU[K comparable, V any] struct {
+	items    map[K]*list.Element[entry[K, V]]
+	ll       *list.List[entry[K, V]]
+	capacity int
+	used     int
+	cost     func(K, V) int
+	onEvict  func(K, V)
+}
+
+// New constructs a new LRU holding up to capacity entries.
+// If onEvict is not nil, it is called for every entry evicted due to the capacity being exceeded.
+func New[K comparable, V any](capacity int, onEvict func(K, V)) *LRU[K, V] {
+	return NewWithCost(capacity, nil, onEvict)
+}
+
+// NewWithCost constructs a new LRU whose entries have the cost reported by cost,
+// evicting entries once their total cost exceeds capacity. A nil cost assigns every entry a cost of one.
+// If onEvict is not nil, it is called for every entry evicted due to the capacity being exceeded.
+func NewWithCost[K comparable, V any](capacity int, cost func(K, V) int, onEvict func(K, V)) *LRU[K, V] {
+	if capacity <= 0 {
+		panic("lru.NewWithCost: capacity must be > 0")
+	}
+	return &LRU[K, V]{
+		items:    make(map[K]*list.Element[entry[K, V]]),
+		ll:       list.New[entry[K, V]](),
+		capacity: capacity,
+		cost:     cost,
+		onEvict:  onEvict,
+	}
+}
+
+// Len returns the number of entries in the cache.
+func (c *LRU[K, V]) Len() int {
+	return c.ll.Len()
+}
+
+// Cost returns the total cost of the entries in the cache.
+func (c *LRU[K, V]) Cost() int {
+	return c.used
+}
+
+// Put stores value for key and marks it as most recently used.
+// If the total cost then exceeds the capacity, the least recently used entries are evicted,
+// which includes the new entry if its cost alone exceeds the capacity.
+func (c *LRU[K, V]) Put(key K, value V) {
+	cost := 1
+	if c.cost != nil {
+		cost = c.cost(key, value)
+	}
+
+	if e, ok := c.items[key]; ok {
+		c.used += cost - e.Value.cost
+		e.Value.value = value
+		e.Value.cost = cost
+		c.ll.MoveToFront(e)
+	} else {
+		c.items[key] = c.ll.PushFront(entry[K, V]{key, value, cost})
+		c.used += cost
+	}
+
+	for c.used > c.capacity {
+		c.evict(c.ll.Back())
+	}
+}
+
+// Get returns the value stored for key, if any, and marks it as most recently used.
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.ll.MoveToFront(e)
+	return e.Value.value, true
+}
+
+// Peek returns the value stored for key, if any, without marking it as most recently used.
+func (c *LRU[K, V]) Peek(key K) (V, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return e.Value.value, true
+}
+
+// Delete removes the entry for key and reports whether it was present. onEvict is not called.
+func (c *LRU[K, V]) Delete(key K) bool {
+	e, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	c.remove(e)
+	return true
+}
+
+// Oldest returns the least recently used entry, if any, without marking it as most recently used.
+func (c *LRU[K, V]) Oldest() (K, V, bool) {
+	e := c.ll.Back()
+	if e == nil {
+		var (
+			zeroK K
+			zeroV V
+		)
+		return zeroK, zeroV, false
+	}
+	return e.Value.key, e.Value.value, true
+}
+
+// Clear removes all entries from the cache. onEvict is not called.
+func (c *LRU[K, V]) Clear() {
+	clear(c.items)
+	c.ll.Init()
+	c.used = 0
+}
+
+// All returns an iterator over the entries of the cache, from most to least recently used.
+// Iterating does not mark entries as used. The cache must not be modified during iteration.
+func (c *LRU[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := c.ll.Front(); e != nil; e = e.Next() {
+			if !yield(e.Value.key, e.Value.value) {
+				return
+			}
+		}
+	}
+}
+
+func (c *LRU[K, V]) remove(e *list.Element[entry[K, V]]) entry[K, V] {
+	delete(c.items, e.Value.key)
+	c.used -= e.Value.cost
+	return c.ll.Remove(e)
+}
+
+func (c *LRU[K, V]) evict(e *list.Element[entry[K, V]]) {
+	ent := c.remove(e)
+	if c.onEvict != nil {
+		c.onEvict(ent.key, ent.value)
+	}
+}
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package lru
+
+import (
+	"maps"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+var (
+	_ Cache[int, int] = (*LRU[int, int])(nil)
+	_ Cache[int, int] = (*TTL[int, int])(nil)
+	_ Cache[int, int] = (*Sync[int, int])(nil)
+)
+
+func keys[K comparable, V any](c Cache[K, V]) []K {
+	var res []K
+	for k := range c.All() {
+		res = append(res, k)
+	}
+	return res
+}
+
+func TestLRU(t *testing.T) {
+	var evicted []int
+	c := New(3, func(k int, _ string) { evicted = append(evicted, k) })
+	c.Put(1, "a")
+	c.Put(2, "b")
+	c.Put(3, "c")
+
+	if v, ok := c.Get(1); !ok || v != "a" {
+		t.Errorf("Get(1) = %q, %v, want a, true", v, ok)
+	}
+	if v, ok := c.Peek(2); !ok || v != "b" {
+		t.Errorf("Peek(2) = %q, %v, want b, true", v, ok)
+	}
+	if got := keys[int, string](c); !slices.Equal(got, []int{1, 3, 2}) {
+		t.Errorf("All() = %v, want [1 3 2]", got)
+	}
+
+	c.Put(4, "d")
+	if !slices.Equal(evicted, []int{2}) {
+		t.Errorf("evicted = %v, want [2]", evicted)
+	}
+	if _, ok := c.Get(2); ok {
+		t.Error("Get(2) after eviction should return false")
+	}
+	if k, _, _ := c.Oldest(); k != 3 {
+		t.Errorf("Oldest() = %d, want 3", k)
+	}
+
+	if !c.Delete(3) || c.Delete(3) {
+		t.Error("Delete(3) should return true once")
+	}
+	if c.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", c.Len())
+	}
+	c.Clear()
+	if c.Len() != 0 || c.Cost() != 0 {
+		t.Errorf("Len(), Cost() = %d, %d, want 0, 0", c.Len(), c.Cost())
+	}
+	if len(evicted) != 1 {
+		t.Errorf("evicted = %v, want [2]", evicted)
+	}
+}
+
+func TestLRUCost(t *testing.T) {
+	var evicted []string
+	c := NewWithCost(10, func(_ string, v []byte) int { return len(v) }, func(k string, _ []byte) { evicted = append(evicted, k) })
+	c.Put("a", make([]byte, 4))
+	c.Put("b", make([]byte, 4))
+	if c.Cost() != 8 {
+		t.Errorf("Cost() = %d, want 8", c.Cost())
+	}
+
+	c.Put("a", make([]byte, 6))
+	if c.Cost() != 10 || len(evicted) != 0 {
+		t.Errorf("Cost() = %d, evicted = %v, want 10, []", c.Cost(), evicted)
+	}
+
+	c.Put("c", make([]byte, 3))
+	if !slices.Equal(evicted, []string{"b"}) || c.Cost() != 9 {
+		t.Errorf("evicted = %v, Cost() = %d, want [b], 9", evicted, c.Cost())
+	}
+
+	c.Put("d", make([]byte, 11))
+	if _, ok := c.Peek("d"); ok {
+		t.Error("entry exceeding the capacity should be evicted")
+	}
+	if c.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", c.Len())
+	}
+}
+
+func TestTTL(t *testing.T) {
+	now := time.Unix(0, 0)
+	var evicted []int
+	c := NewTTL(10, time.Minute, func() time.Time { return now }, func(k, _ int) { evicted = append(evicted, k) })
+
+	c.Put(1, 1)
+	now = now.Add(30 * time.Second)
+	c.Put(2, 2)
+	if v, ok := c.Get(1); !ok || v != 1 {
+		t.Errorf("Get(1) = %d, %v, want 1, true", v, ok)
+	}
+
+	now = now.Add(30 * time.Second)
+	if _, ok := c.Peek(1); ok {
+		t.Error("Peek(1) of expired entry should return false")
+	}
+	if got := keys[int, int](c); !slices.Equal(got, []int{2}) {
+		t.Errorf("All() = %v, want [2]", got)
+	}
+
+	c.Put(3, 3)
+	now = now.Add(30 * time.Second)
+	if n := c.Purge(); n != 1 {
+		t.Errorf("Purge() = %d, want 1", n)
+	}
+	if !slices.Equal(evicted, []int{1, 2}) {
+		t.Errorf("evicted = %v, want [1 2]", evicted)
+	}
+	if c.Len() != 1 {
+		t.Errorf("Len() = %d, want 1", c.Len())
+	}
+}
+
+func TestSync(t *testing.T) {
+	c := NewSync[int, int](New[int, int](100, nil))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				c.Put(j, j)
+				c.Get(j / 2)
+				for range c.All() {
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if c.Len() != 100 {
+		t.Errorf("Len() = %d, want 100", c.Len())
+	}
+	for k, v := range maps.Collect(c.All()) {
+		if k != v {
+			t.Errorf("entry %d = %d", k, v)
+		}
+	}
+}
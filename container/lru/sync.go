@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package lru
+
+import (
+	"iter"
+	"sync"
+)
+
+// Sync wraps a Cache to make it safe for concurrent use.
+// Eviction callbacks of the wrapped Cache are called with the lock held and must not access the Sync.
+type Sync[K comparable, V any] struct {
+	mu    sync.Mutex
+	cache Cache[K, V]
+}
+
+// NewSync wraps cache to make it safe for concurrent use.
+// cache must not be accessed other than through the returned Sync.
+func NewSync[K comparable, V any](cache Cache[K, V]) *Sync[K, V] {
+	return &Sync[K, V]{cache: cache}
+}
+
+// Put stores value for key. See the wrapped Cache for details.
+func (s *Sync[K, V]) Put(key K, value V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cache.Put(key, value)
+}
+
+// Get returns the value stored for key, if any, and marks it as most recently used.
+func (s *Sync[K, V]) Get(key K) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.Get(key)
+}
+
+// Peek returns the value stored for key, if any, without marking it as most recently used.
+func (s *Sync[K, V]) Peek(key K) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.Peek(key)
+}
+
+// Delete removes the entry for key and reports whether it was present.
+func (s *Sync[K, V]) Delete(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.Delete(key)
+}
+
+// Len returns the number of entries in the cache.
+func (s *Sync[K, V]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.Len()
+}
+
+// Clear removes all entries from the cache.
+func (s *Sync[K, V]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cache.Clear()
+}
+
+type kv[K, V any] struct {
+	k K
+	v V
+}
+
+// All returns an iterator over the entries of the cache, from most to least recently used.
+// It iterates over a snapshot taken when iteration starts, so the cache may be modified concurrently.
+func (s *Sync[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		s.mu.Lock()
+		var snapshot []kv[K, V]
+		for k, v := range s.cache.All() {
+			snapshot = append(snapshot, kv[K, V]{k, v})
+		}
+		s.mu.Unlock()
+
+		for _, e := range snapshot {
+			if !yield(e.k, e.v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package lru
+
+import (
+	"iter"
+	"time"
+)
+
+type ttlEntry[V any] struct {
+	value   V
+	expires time.Time
+}
+
+// TTL is an LRU whose entries additionally expire a fixed duration after they were put.
+// Expired entries are removed lazily when they are accessed, or eagerly by Purge.
+type TTL[K comparable, V any] struct {
+	lru     *LRU[K, ttlEntry[V]]
+	ttl     time.Duration
+	now     func() time.Time
+	onEvict func(K, V)
+}
+
+// NewTTL constructs a new TTL holding up to capacity entries that expire ttl after they were put.
+// The current time is obtained from now; if now is nil, time.Now is used.
+// If onEvict is not nil, it is called for every entry evicted due to the capacity being exceeded
+// or removed because it expired.
+func NewTTL[K comparable, V any](capacity int, ttl time.Duration, now func() time.Time, onEvict func(K, V)) *TTL[K, V] {
+	if ttl <= 0 {
+		panic("lru.NewTTL: ttl must be > 0")
+	}
+	if now == nil {
+		now = time.Now
+	}
+
+	c := &TTL[K, V]{
+		ttl:     ttl,
+		now:     now,
+		onEvict: onEvict,
+	}
+	var lruOnEvict func(K, ttlEntry[V])
+	if onEvict != nil {
+		lruOnEvict = func(k K, e ttlEntry[V]) { onEvict(k, e.value) }
+	}
+	c.lru = New(capacity, lruOnEvict)
+	return c
+}
+
+// Len returns the number of entries in the cache, including expired entries that have not been removed yet.
+func (c *TTL[K, V]) Len() int {
+	return c.lru.Len()
+}
+
+// Put stores value for key, marks it as most recently used and resets its expiry.
+func (c *TTL[K, V]) Put(key K, value V) {
+	c.lru.Put(key, ttlEntry[V]{value, c.now().Add(c.ttl)})
+}
+
+// expire removes the entry for key if it expired, reporting whether it did.
+func (c *TTL[K, V]) expire(key K, e ttlEntry[V], now time.Time) bool {
+	if now.Before(e.expires) {
+		return false
+	}
+	c.lru.Delete(key)
+	if c.onEvict != nil {
+		c.onEvict(key, e.value)
+	}
+	return true
+}
+
+// Get returns the value stored for key, if any and not expired, and marks it as most recently used.
+func (c *TTL[K, V]) Get(key K) (V, bool) {
+	e, ok := c.lru.Peek(key)
+	if !ok || c.expire(key, e, c.now()) {
+		var zero V
+		return zero, false
+	}
+	c.lru.Get(key)
+	return e.value, true
+}
+
+// Peek returns the value stored for key, if any and not expired, without marking it as most recently used.
+func (c *TTL[K, V]) Peek(key K) (V, bool) {
+	e, ok := c.lru.Peek(key)
+	if !ok || c.expire(key, e, c.now()) {
+		var zero V
+		return zero, false
+	}
+	return e.value, true
+}
+
+// Delete removes the entry for key and reports whether it was present. onEvict is not called.
+func (c *TTL[K, V]) Delete(key K) bool {
+	return c.lru.Delete(key)
+}
+
+// Purge removes all expired entries and returns their number.
+func (c *TTL[K, V]) Purge() int {
+	var (
+		now     = c.now()
+		expired []K
+	)
+	for k, e := range c.lru.All() {
+		if !now.Before(e.expires) {
+			expired = append(expired, k)
+		}
+	}
+	for _, k := range expired {
+		e, _ := c.lru.Peek(k)
+		c.expire(k, e, now)
+	}
+	return len(expired)
+}
+
+// Clear removes all entries from the cache. onEvict is not called.
+func (c *TTL[K, V]) Clear() {
+	c.lru.Clear()
+}
+
+// All returns an iterator over the entries of the cache that are not expired, from most to least recently used.
+// Iterating does not mark entries as used. The cache must not be modified during iteration.
+func (c *TTL[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		now := c.now()
+		for k, e := range c.lru.All() {
+			if now.Before(e.expires) && !yield(k, e.value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ring
+
+import (
+	"iter"
+)
+
+// FullPolicy determines what Buffer.Push does if the Buffer is full.
+type FullPolicy int
+
+const (
+	// Reject discards the element being pushed.
+	Reject FullPolicy = iota
+	// Overwrite discards the oldest element to make room for the element being pushed.
+	Overwrite
+)
+
+// Buffer is a ring buffer with a fixed capacity.
+// Unlike squeue.SQueue, it never grows; its policy determines what happens when it is full.
+type Buffer[E any] struct {
+	data   []E
+	start  int // Index where the oldest element is located at
+	len    int
+	policy FullPolicy
+}
+
+// NewBuffer constructs a new Buffer holding up to capacity elements.
+func NewBuffer[E any](capacity int, policy FullPolicy) *Buffer[E] {
+	if capacity <= 0 {
+		panic("ring.NewBuffer: capacity must be > 0")
+	}
+	return &Buffer[E]{
+		data:   make([]E, capacity),
+		policy: policy,
+	}
+}
+
+// Len returns the number of elements in the buffer.
+func (b *Buffer[E]) Len() int {
+	return b.len
+}
+
+// Cap returns the capacity of the buffer.
+func (b *Buffer[E]) Cap() int {
+	return len(b.data)
+}
+
+// Full reports whether the buffer holds Cap elements.
+func (b *Buffer[E]) Full() bool {
+	return b.len == len(b.data)
+}
+
+func (b *Buffer[E]) index(i int) int {
+	return (b.start + i) % len(b.data)
+}
+
+// Push adds e as the newest element of the buffer and reports whether it was added.
+// If the buffer is full, e is discarded with Reject, while the oldest element is discarded with Overwrite.
+func (b *Buffer[E]) Push(e E) bool {
+	if b.Full() {
+		if b.policy == Reject {
+			return false
+		}
+		b.data[b.start] = e
+		b.start = b.index(1)
+		return true
+	}
+	b.data[b.index(b.len)] = e
+	b.len++
+	return true
+}
+
+// Pop removes and returns the oldest element of the buffer.
+func (b *Buffer[E]) Pop() (E, bool) {
+	if b.len == 0 {
+		var zero E
+		return zero, false
+	}
+	e := b.data[b.start]
+	var zero E
+	b.data[b.start] = zero // Zero the value to help GC
+	b.start = b.index(1)
+	b.len--
+	return e, true
+}
+
+// Peek returns the oldest element of the buffer without removing it.
+func (b *Buffer[E]) Peek() (E, bool) {
+	if b.len == 0 {
+		var zero E
+		return zero, false
+	}
+	return b.data[b.start], true
+}
+
+// Clear removes all elements from the buffer.
+func (b *Buffer[E]) Clear() {
+	clear(b.data)
+	b.start = 0
+	b.len = 0
+}
+
+// All returns an iterator over the elements of the buffer, from oldest to newest.
+// The buffer must not be modified during iteration.
+func (b *Buffer[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for i := 0; i < b.len; i++ {
+			if !yield(b.data[b.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Snapshot returns a copy of the elements of the buffer, from oldest to newest.
+func (b *Buffer[E]) Snapshot() []E {
+	res := make([]E, b.len)
+	n := copy(res, b.data[b.start:min(b.start+b.len, len(b.data))])
+	copy(res[n:], b.data)
+	return res
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ring
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestBufferReject(t *testing.T) {
+	b := NewBuffer[int](3, Reject)
+	for i := 0; i < 3; i++ {
+		if !b.Push(i) {
+			t.Errorf("Push(%d) = false, want true", i)
+		}
+	}
+	if b.Push(3) {
+		t.Error("Push() on full buffer = true, want false")
+	}
+	if got := b.Snapshot(); !slices.Equal(got, []int{0, 1, 2}) {
+		t.Errorf("Snapshot() = %v, want [0 1 2]", got)
+	}
+
+	if v, ok := b.Pop(); !ok || v != 0 {
+		t.Errorf("Pop() = %d, %v, want 0, true", v, ok)
+	}
+	b.Push(3)
+	if got := slices.Collect(b.All()); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("All() = %v, want [1 2 3]", got)
+	}
+}
+
+func TestBufferOverwrite(t *testing.T) {
+	b := NewBuffer[int](3, Overwrite)
+	for i := 0; i < 5; i++ {
+		if !b.Push(i) {
+			t.Errorf("Push(%d) = false, want true", i)
+		}
+	}
+	if b.Len() != 3 || !b.Full() {
+		t.Errorf("Len() = %d, want 3", b.Len())
+	}
+	if got := b.Snapshot(); !slices.Equal(got, []int{2, 3, 4}) {
+		t.Errorf("Snapshot() = %v, want [2 3 4]", got)
+	}
+	if v, ok := b.Peek(); !ok || v != 2 {
+		t.Errorf("Peek() = %d, %v, want 2, true", v, ok)
+	}
+
+	b.Clear()
+	if _, ok := b.Pop(); ok {
+		t.Error("Pop() on empty buffer should return false")
+	}
+	if got := b.Snapshot(); len(got) != 0 {
+		t.Errorf("Snapshot() = %v, want []", got)
+	}
+}
+
+func TestSyncBuffer(t *testing.T) {
+	b := NewSyncBuffer[int](10, Overwrite)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				b.Push(j)
+				for range b.All() {
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if b.Len() != 10 {
+		t.Errorf("Len() = %d, want 10", b.Len())
+	}
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ring
+
+import (
+	"slices"
+	"testing"
+)
+
+func values[E any](r *Ring[E]) []E {
+	var res []E
+	r.Do(func(e E) { res = append(res, e) })
+	return res
+}
+
+func TestRing(t *testing.T) {
+	r := New[int](4)
+	if r.Len() != 4 {
+		t.Errorf("Len() = %d, want 4", r.Len())
+	}
+	for i := 0; i < 4; i++ {
+		r.Value = i
+		r = r.Next()
+	}
+	if got := values(r); !slices.Equal(got, []int{0, 1, 2, 3}) {
+		t.Errorf("Do() = %v, want [0 1 2 3]", got)
+	}
+	if v := r.Move(-1).Value; v != 3 {
+		t.Errorf("Move(-1).Value = %d, want 3", v)
+	}
+	if v := r.Prev().Value; v != 3 {
+		t.Errorf("Prev().Value = %d, want 3", v)
+	}
+
+	removed := r.Unlink(2)
+	if got := values(removed); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("Unlink(2) = %v, want [1 2]", got)
+	}
+	if got := values(r); !slices.Equal(got, []int{0, 3}) {
+		t.Errorf("Do() = %v, want [0 3]", got)
+	}
+
+	r.Link(removed)
+	if got := values(r); !slices.Equal(got, []int{0, 1, 2, 3}) {
+		t.Errorf("Do() = %v, want [0 1 2 3]", got)
+	}
+
+	if New[int](0) != nil {
+		t.Error("New(0) should return nil")
+	}
+	var zero Ring[int]
+	if zero.Len() != 1 {
+		t.Errorf("Len() = %d, want 1", zero.Len())
+	}
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ring
+
+import (
+	"iter"
+	"sync"
+)
+
+// SyncBuffer is a Buffer that is safe for concurrent use.
+type SyncBuffer[E any] struct {
+	mu  sync.Mutex
+	buf *Buffer[E]
+}
+
+// NewSyncBuffer constructs a new SyncBuffer holding up to capacity elements.
+func NewSyncBuffer[E any](capacity int, policy FullPolicy) *SyncBuffer[E] {
+	return &SyncBuffer[E]{buf: NewBuffer[E](capacity, policy)}
+}
+
+// Len returns the number of elements in the buffer.
+func (b *SyncBuffer[E]) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Len()
+}
+
+// Cap returns the capacity of the buffer.
+func (b *SyncBuffer[E]) Cap() int {
+	return b.buf.Cap()
+}
+
+// Push adds e as the newest element of the buffer and reports whether it was added.
+// See Buffer.Push for details.
+func (b *SyncBuffer[E]) Push(e E) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Push(e)
+}
+
+// Pop removes and returns the oldest element of the buffer.
+func (b *SyncBuffer[E]) Pop() (E, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Pop()
+}
+
+// Peek returns the oldest element of the buffer without removing it.
+func (b *SyncBuffer[E]) Peek() (E, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Peek()
+}
+
+// Clear removes all elements from the buffer.
+func (b *SyncBuffer[E]) Clear() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.buf.Clear()
+}
+
+// All returns an iterator over the elements of the buffer, from oldest to newest.
+// It iterates over a snapshot taken when iteration starts, so the buffer may be modified concurrently.
+func (b *SyncBuffer[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for _, e := range b.Snapshot() {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// Snapshot returns a copy of the elements of the buffer, from oldest to newest.
+func (b *SyncBuffer[E]) Snapshot() []E {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Snapshot()
+}
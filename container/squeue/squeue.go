@@ -3,12 +3,19 @@
 
 package squeue
 
+import (
+	"fmt"
+	"iter"
+)
+
 // SQueue is a queue that internally uses a slice, hence S(lice)Queue.
 // Depending on the amount of data, the queue dynamically shrinks or grows.
+// Elements can be added and removed at both ends, so it can also be used as a double-ended queue.
 type SQueue[E any] struct {
 	data     []E
 	start    int // Index where first element is located at
 	readable int
+	minCap   int // Capacity the queue never shrinks below
 }
 
 // New constructs a new SQueue instance with provided parameters.
@@ -18,6 +25,18 @@ func New[E any](initialSize int) *SQueue[E] {
 	}
 }
 
+// NewWithMinCap constructs a new SQueue instance that never shrinks below minCap.
+// This avoids repeatedly shrinking and growing the queue for bursty workloads.
+func NewWithMinCap[E any](initialSize, minCap int) *SQueue[E] {
+	if minCap < 0 {
+		panic("squeue.NewWithMinCap: negative minCap")
+	}
+	return &SQueue[E]{
+		data:   make([]E, max(initialSize, minCap)),
+		minCap: minCap,
+	}
+}
+
 // Len returns the number of elements in the queue.
 func (r *SQueue[E]) Len() int {
 	return r.readable
@@ -28,6 +47,11 @@ func (r *SQueue[E]) Cap() int {
 	return len(r.data)
 }
 
+// index returns the index in data of the i-th element.
+func (r *SQueue[E]) index(i int) int {
+	return (r.start + i) % len(r.data)
+}
+
 // migrateTo migrates the queue to a new underlying slice.
 func (r *SQueue[E]) migrateTo(newData []E) {
 	to := r.start + r.readable
@@ -41,8 +65,50 @@ func (r *SQueue[E]) migrateTo(newData []E) {
 	r.data = newData
 }
 
-// Dequeue dequeues an element.
+// grow grows the queue if it is full.
+func (r *SQueue[E]) grow() {
+	if r.readable < len(r.data) {
+		return
+	}
+	r.migrateTo(make([]E, max(1, len(r.data)*2)))
+}
+
+// shrink shrinks the queue if it is less than a quarter full, but not below its minimum capacity.
+func (r *SQueue[E]) shrink() {
+	if r.readable > 0 && r.readable < len(r.data)/4 {
+		if newCap := max(len(r.data)/2, r.minCap); newCap < len(r.data) {
+			r.migrateTo(make([]E, newCap))
+		}
+	}
+}
+
+// Dequeue dequeues an element. It is equivalent to PopFront.
 func (r *SQueue[E]) Dequeue() (data E, ok bool) {
+	return r.PopFront()
+}
+
+// Enqueue enqueues an item. It is equivalent to PushBack.
+func (r *SQueue[E]) Enqueue(data E) {
+	r.PushBack(data)
+}
+
+// PushBack adds an element at the back of the queue.
+func (r *SQueue[E]) PushBack(data E) {
+	r.grow()
+	r.data[r.index(r.readable)] = data
+	r.readable++
+}
+
+// PushFront adds an element at the front of the queue.
+func (r *SQueue[E]) PushFront(data E) {
+	r.grow()
+	r.start = (r.start + len(r.data) - 1) % len(r.data)
+	r.data[r.start] = data
+	r.readable++
+}
+
+// PopFront removes and returns the element at the front of the queue.
+func (r *SQueue[E]) PopFront() (data E, ok bool) {
 	if r.readable == 0 {
 		var zero E
 		return zero, false
@@ -51,34 +117,82 @@ func (r *SQueue[E]) Dequeue() (data E, ok bool) {
 	element := r.data[r.start]
 	var zero E
 	r.data[r.start] = zero // Zero the value to help GC
-	if r.start == len(r.data)-1 {
-		// Was the last element
-		r.start = 0
-	} else {
-		r.start++
-	}
+	r.start = r.index(1)
 
-	if r.readable > 0 && r.readable < len(r.data)/4 {
-		// need to shrink
-		r.migrateTo(make([]E, len(r.data)/2))
+	r.shrink()
+	return element, true
+}
+
+// PopBack removes and returns the element at the back of the queue.
+func (r *SQueue[E]) PopBack() (data E, ok bool) {
+	if r.readable == 0 {
+		var zero E
+		return zero, false
 	}
+	r.readable--
+	idx := r.index(r.readable)
+	element := r.data[idx]
+	var zero E
+	r.data[idx] = zero // Zero the value to help GC
 
+	r.shrink()
 	return element, true
 }
 
-// Enqueue enqueues an item.
-func (r *SQueue[E]) Enqueue(data E) {
-	if r.readable == len(r.data) {
-		// need to grow
-		if len(r.data) == 0 {
-			r.data = make([]E, 1)
+// PeekFront returns the element at the front of the queue without removing it.
+func (r *SQueue[E]) PeekFront() (data E, ok bool) {
+	if r.readable == 0 {
+		var zero E
+		return zero, false
+	}
+	return r.data[r.start], true
+}
+
+// PeekBack returns the element at the back of the queue without removing it.
+func (r *SQueue[E]) PeekBack() (data E, ok bool) {
+	if r.readable == 0 {
+		var zero E
+		return zero, false
+	}
+	return r.data[r.index(r.readable-1)], true
+}
+
+// At returns the i-th element of the queue, counting from the front.
+// It panics if i is out of range.
+func (r *SQueue[E]) At(i int) E {
+	if i < 0 || i >= r.readable {
+		panic(fmt.Sprintf("squeue.SQueue.At: index %d out of range [0:%d]", i, r.readable))
+	}
+	return r.data[r.index(i)]
+}
+
+// Clear removes all elements from the queue, retaining its capacity.
+func (r *SQueue[E]) Clear() {
+	clear(r.data)
+	r.start = 0
+	r.readable = 0
+}
+
+// All returns an iterator over the index-element pairs of the queue, from front to back.
+// The queue must not be modified during iteration.
+func (r *SQueue[E]) All() iter.Seq2[int, E] {
+	return func(yield func(int, E) bool) {
+		for i := 0; i < r.readable; i++ {
+			if !yield(i, r.data[r.index(i)]) {
+				return
+			}
 		}
-		r.migrateTo(make([]E, len(r.data)*2))
 	}
+}
 
-	if len(r.data) == 0 {
-		r.data = make([]E, 1)
+// Backward returns an iterator over the index-element pairs of the queue, from back to front.
+// The queue must not be modified during iteration.
+func (r *SQueue[E]) Backward() iter.Seq2[int, E] {
+	return func(yield func(int, E) bool) {
+		for i := r.readable - 1; i >= 0; i-- {
+			if !yield(i, r.data[r.index(i)]) {
+				return
+			}
+		}
 	}
-	r.data[(r.readable+r.start)%len(r.data)] = data
-	r.readable++
 }
@@ -4,6 +4,7 @@
 package squeue
 
 import (
+	"slices"
 	"testing"
 )
 
@@ -73,3 +74,97 @@ func TestSQueue_EnqueueDequeue(t *testing.T) {
 		}
 	}
 }
+
+func TestSQueue_Deque(t *testing.T) {
+	q := New[int](0)
+	q.PushBack(2)
+	q.PushFront(1)
+	q.PushBack(3)
+	q.PushFront(0)
+
+	if v, ok := q.PeekFront(); !ok || v != 0 {
+		t.Errorf("PeekFront() = %d, %v, want 0, true", v, ok)
+	}
+	if v, ok := q.PeekBack(); !ok || v != 3 {
+		t.Errorf("PeekBack() = %d, %v, want 3, true", v, ok)
+	}
+	for i := 0; i < 4; i++ {
+		if v := q.At(i); v != i {
+			t.Errorf("At(%d) = %d, want %d", i, v, i)
+		}
+	}
+
+	v, ok := q.PopBack()
+	if !ok || v != 3 {
+		t.Errorf("PopBack() = %d, %v, want 3, true", v, ok)
+	}
+	v, ok = q.PopFront()
+	if !ok || v != 0 {
+		t.Errorf("PopFront() = %d, %v, want 0, true", v, ok)
+	}
+	if q.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", q.Len())
+	}
+
+	q.Clear()
+	if q.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", q.Len())
+	}
+	if _, ok := q.PopBack(); ok {
+		t.Error("PopBack() on empty queue should return false")
+	}
+	if _, ok := q.PeekFront(); ok {
+		t.Error("PeekFront() on empty queue should return false")
+	}
+}
+
+func TestSQueue_Iterators(t *testing.T) {
+	q := New[int](4)
+	// Wrap around the end of the underlying slice.
+	q.Enqueue(0)
+	q.Enqueue(0)
+	q.Dequeue()
+	q.Dequeue()
+	for i := 1; i <= 4; i++ {
+		q.Enqueue(i)
+	}
+
+	var got []int
+	for i, v := range q.All() {
+		if v != q.At(i) {
+			t.Errorf("All() yielded %d at %d, want %d", v, i, q.At(i))
+		}
+		got = append(got, v)
+	}
+	if !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Errorf("All() = %v, want [1 2 3 4]", got)
+	}
+
+	got = got[:0]
+	for _, v := range q.Backward() {
+		got = append(got, v)
+	}
+	if !slices.Equal(got, []int{4, 3, 2, 1}) {
+		t.Errorf("Backward() = %v, want [4 3 2 1]", got)
+	}
+}
+
+func TestSQueue_MinCap(t *testing.T) {
+	q := NewWithMinCap[int](0, 8)
+	if q.Cap() != 8 {
+		t.Errorf("Cap() = %d, want 8", q.Cap())
+	}
+
+	for i := 0; i < 32; i++ {
+		q.Enqueue(i)
+	}
+	for i := 0; i < 31; i++ {
+		q.Dequeue()
+	}
+	if q.Cap() != 8 {
+		t.Errorf("Cap() = %d, want 8", q.Cap())
+	}
+	if v, ok := q.Dequeue(); !ok || v != 31 {
+		t.Errorf("Dequeue() = %d, %v, want 31, true", v, ok)
+	}
+}
@@ -0,0 +1,404 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package treemap provides a map that keeps its keys sorted, implemented as a left-leaning red-black tree.
+package treemap
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+)
+
+type node[K, V any] struct {
+	key         K
+	value       V
+	left, right *node[K, V]
+	red         bool
+	size        int // Number of nodes in the subtree rooted at this node
+}
+
+// TreeMap is a map whose keys are ordered by a comparison function.
+// Lookups, insertions and deletions take O(log n) time, and iteration is in key order.
+type TreeMap[K, V any] struct {
+	root    *node[K, V]
+	compare func(K, K) int
+}
+
+// New constructs a new empty TreeMap ordered by compare.
+func New[K, V any](compare func(k1, k2 K) int) *TreeMap[K, V] {
+	return &TreeMap[K, V]{compare: compare}
+}
+
+// NewOrdered constructs a new empty TreeMap ordered by the natural order of K.
+func NewOrdered[K cmp.Ordered, V any]() *TreeMap[K, V] {
+	return New[K, V](cmp.Compare[K])
+}
+
+func isRed[K, V any](n *node[K, V]) bool {
+	return n != nil && n.red
+}
+
+func size[K, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func rotateLeft[K, V any](h *node[K, V]) *node[K, V] {
+	x := h.right
+	h.right = x.left
+	x.left = h
+	x.red = h.red
+	h.red = true
+	x.size = h.size
+	h.size = 1 + size(h.left) + size(h.right)
+	return x
+}
+
+func rotateRight[K, V any](h *node[K, V]) *node[K, V] {
+	x := h.left
+	h.left = x.right
+	x.right = h
+	x.red = h.red
+	h.red = true
+	x.size = h.size
+	h.size = 1 + size(h.left) + size(h.right)
+	return x
+}
+
+func flipColors[K, V any](h *node[K, V]) {
+	h.red = !h.red
+	h.left.red = !h.left.red
+	h.right.red = !h.right.red
+}
+
+// balance restores the left-leaning red-black invariants on the way up the tree.
+func balance[K, V any](h *node[K, V]) *node[K, V] {
+	if isRed(h.right) && !isRed(h.left) {
+		h = rotateLeft(h)
+	}
+	if isRed(h.left) && isRed(h.left.left) {
+		h = rotateRight(h)
+	}
+	if isRed(h.left) && isRed(h.right) {
+		flipColors(h)
+	}
+	h.size = 1 + size(h.left) + size(h.right)
+	return h
+}
+
+func moveRedLeft[K, V any](h *node[K, V]) *node[K, V] {
+	flipColors(h)
+	if isRed(h.right.left) {
+		h.right = rotateRight(h.right)
+		h = rotateLeft(h)
+		flipColors(h)
+	}
+	return h
+}
+
+func moveRedRight[K, V any](h *node[K, V]) *node[K, V] {
+	flipColors(h)
+	if isRed(h.left.left) {
+		h = rotateRight(h)
+		flipColors(h)
+	}
+	return h
+}
+
+func minNode[K, V any](h *node[K, V]) *node[K, V] {
+	for h.left != nil {
+		h = h.left
+	}
+	return h
+}
+
+func deleteMin[K, V any](h *node[K, V]) *node[K, V] {
+	if h.left == nil {
+		return nil
+	}
+	if !isRed(h.left) && !isRed(h.left.left) {
+		h = moveRedLeft(h)
+	}
+	h.left = deleteMin(h.left)
+	return balance(h)
+}
+
+func (m *TreeMap[K, V]) find(key K) *node[K, V] {
+	n := m.root
+	for n != nil {
+		c := m.compare(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+// Len returns the number of entries in the map.
+func (m *TreeMap[K, V]) Len() int {
+	return size(m.root)
+}
+
+// Get returns the value stored for key, if any.
+func (m *TreeMap[K, V]) Get(key K) (V, bool) {
+	n := m.find(key)
+	if n == nil {
+		var zero V
+		return zero, false
+	}
+	return n.value, true
+}
+
+// Put stores value for key, replacing any previous value.
+func (m *TreeMap[K, V]) Put(key K, value V) {
+	m.root = m.put(m.root, key, value)
+	m.root.red = false
+}
+
+func (m *TreeMap[K, V]) put(h *node[K, V], key K, value V) *node[K, V] {
+	if h == nil {
+		return &node[K, V]{key: key, value: value, red: true, size: 1}
+	}
+	c := m.compare(key, h.key)
+	switch {
+	case c < 0:
+		h.left = m.put(h.left, key, value)
+	case c > 0:
+		h.right = m.put(h.right, key, value)
+	default:
+		h.value = value
+	}
+	return balance(h)
+}
+
+// Delete removes the entry for key and reports whether it was present.
+func (m *TreeMap[K, V]) Delete(key K) bool {
+	if m.find(key) == nil {
+		return false
+	}
+	if !isRed(m.root.left) && !isRed(m.root.right) {
+		m.root.red = true
+	}
+	m.root = m.delete(m.root, key)
+	if m.root != nil {
+		m.root.red = false
+	}
+	return true
+}
+
+// delete removes key from the subtree rooted at h. key has to be present in it.
+func (m *TreeMap[K, V]) delete(h *node[K, V], key K) *node[K, V] {
+	if m.compare(key, h.key) < 0 {
+		if !isRed(h.left) && !isRed(h.left.left) {
+			h = moveRedLeft(h)
+		}
+		h.left = m.delete(h.left, key)
+		return balance(h)
+	}
+
+	if isRed(h.left) {
+		h = rotateRight(h)
+	}
+	if m.compare(key, h.key) == 0 && h.right == nil {
+		return nil
+	}
+	if !isRed(h.right) && !isRed(h.right.left) {
+		h = moveRedRight(h)
+	}
+	if m.compare(key, h.key) == 0 {
+		x := minNode(h.right)
+		h.key, h.value = x.key, x.value
+		h.right = deleteMin(h.right)
+	} else {
+		h.right = m.delete(h.right, key)
+	}
+	return balance(h)
+}
+
+// Clear removes all entries from the map.
+func (m *TreeMap[K, V]) Clear() {
+	m.root = nil
+}
+
+func entryOf[K, V any](n *node[K, V]) (K, V, bool) {
+	if n == nil {
+		var (
+			zeroK K
+			zeroV V
+		)
+		return zeroK, zeroV, false
+	}
+	return n.key, n.value, true
+}
+
+// Min returns the entry with the smallest key, if any.
+func (m *TreeMap[K, V]) Min() (K, V, bool) {
+	if m.root == nil {
+		return entryOf[K, V](nil)
+	}
+	return entryOf(minNode(m.root))
+}
+
+// Max returns the entry with the largest key, if any.
+func (m *TreeMap[K, V]) Max() (K, V, bool) {
+	n := m.root
+	for n != nil && n.right != nil {
+		n = n.right
+	}
+	return entryOf(n)
+}
+
+// Floor returns the entry with the largest key less than or equal to key, if any.
+func (m *TreeMap[K, V]) Floor(key K) (K, V, bool) {
+	var res *node[K, V]
+	for n := m.root; n != nil; {
+		c := m.compare(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			res = n
+			n = n.right
+		default:
+			return entryOf(n)
+		}
+	}
+	return entryOf(res)
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to key, if any.
+func (m *TreeMap[K, V]) Ceiling(key K) (K, V, bool) {
+	var res *node[K, V]
+	for n := m.root; n != nil; {
+		c := m.compare(key, n.key)
+		switch {
+		case c < 0:
+			res = n
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return entryOf(n)
+		}
+	}
+	return entryOf(res)
+}
+
+// Rank returns the number of keys in the map that are less than key.
+func (m *TreeMap[K, V]) Rank(key K) int {
+	var rank int
+	for n := m.root; n != nil; {
+		c := m.compare(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			rank += 1 + size(n.left)
+			n = n.right
+		default:
+			return rank + size(n.left)
+		}
+	}
+	return rank
+}
+
+// Select returns the entry whose key has the given rank, i.e. the i-th smallest key, counting from zero.
+// It panics if i is out of range.
+func (m *TreeMap[K, V]) Select(i int) (K, V) {
+	if i < 0 || i >= m.Len() {
+		panic(fmt.Sprintf("treemap.TreeMap.Select: index %d out of range [0:%d]", i, m.Len()))
+	}
+	n := m.root
+	for {
+		l := size(n.left)
+		switch {
+		case i < l:
+			n = n.left
+		case i > l:
+			i -= l + 1
+			n = n.right
+		default:
+			return n.key, n.value
+		}
+	}
+}
+
+func ascend[K, V any](n *node[K, V], yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return ascend(n.left, yield) && yield(n.key, n.value) && ascend(n.right, yield)
+}
+
+func descend[K, V any](n *node[K, V], yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return descend(n.right, yield) && yield(n.key, n.value) && descend(n.left, yield)
+}
+
+func (m *TreeMap[K, V]) ascendRange(n *node[K, V], lo, hi K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	geLo := m.compare(n.key, lo) >= 0
+	ltHi := m.compare(n.key, hi) < 0
+	if geLo && !m.ascendRange(n.left, lo, hi, yield) {
+		return false
+	}
+	if geLo && ltHi && !yield(n.key, n.value) {
+		return false
+	}
+	if ltHi && !m.ascendRange(n.right, lo, hi, yield) {
+		return false
+	}
+	return true
+}
+
+// All returns an iterator over the entries of the map in ascending key order.
+// The map must not be modified during iteration.
+func (m *TreeMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		ascend(m.root, yield)
+	}
+}
+
+// Backward returns an iterator over the entries of the map in descending key order.
+// The map must not be modified during iteration.
+func (m *TreeMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		descend(m.root, yield)
+	}
+}
+
+// Range returns an iterator over the entries with lo <= key < hi in ascending key order.
+// The map must not be modified during iteration.
+func (m *TreeMap[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.ascendRange(m.root, lo, hi, yield)
+	}
+}
+
+// Keys returns an iterator over the keys of the map in ascending order.
+// The map must not be modified during iteration.
+func (m *TreeMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		ascend(m.root, func(k K, _ V) bool { return yield(k) })
+	}
+}
+
+// Values returns an iterator over the values of the map in ascending key order.
+// The map must not be modified during iteration.
+func (m *TreeMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		ascend(m.root, func(_ K, v V) bool { return yield(v) })
+	}
+}
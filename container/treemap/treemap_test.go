@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package treemap
+
+import (
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// verify checks the left-leaning red-black invariants and subtree sizes, returning the black height.
+func verify[K, V any](t *testing.T, n *node[K, V]) int {
+	t.Helper()
+	if n == nil {
+		return 1
+	}
+	if isRed(n.right) {
+		t.Fatalf("right-leaning red link at %v", n.key)
+	}
+	if isRed(n) && isRed(n.left) {
+		t.Fatalf("two consecutive red links at %v", n.key)
+	}
+	if n.size != 1+size(n.left)+size(n.right) {
+		t.Fatalf("wrong size at %v", n.key)
+	}
+	l, r := verify(t, n.left), verify(t, n.right)
+	if l != r {
+		t.Fatalf("unbalanced black height at %v", n.key)
+	}
+	if !isRed(n) {
+		l++
+	}
+	return l
+}
+
+func TestTreeMapRandom(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	m := NewOrdered[int, int]()
+	want := make(map[int]int)
+
+	for i := 0; i < 2000; i++ {
+		k := r.IntN(200)
+		if r.IntN(3) == 0 {
+			_, ok := want[k]
+			if got := m.Delete(k); got != ok {
+				t.Fatalf("Delete(%d) = %v, want %v", k, got, ok)
+			}
+			delete(want, k)
+		} else {
+			m.Put(k, i)
+			want[k] = i
+		}
+		verify(t, m.root)
+	}
+
+	if m.Len() != len(want) {
+		t.Errorf("Len() = %d, want %d", m.Len(), len(want))
+	}
+	if got := maps.Collect(m.All()); !maps.Equal(got, want) {
+		t.Errorf("All() = %v, want %v", got, want)
+	}
+	keys := slices.Sorted(maps.Keys(want))
+	if got := slices.Collect(m.Keys()); !slices.Equal(got, keys) {
+		t.Errorf("Keys() = %v, want %v", got, keys)
+	}
+	for i, k := range keys {
+		if v, ok := m.Get(k); !ok || v != want[k] {
+			t.Errorf("Get(%d) = %d, %v, want %d, true", k, v, ok, want[k])
+		}
+		if got := m.Rank(k); got != i {
+			t.Errorf("Rank(%d) = %d, want %d", k, got, i)
+		}
+		if got, _ := m.Select(i); got != k {
+			t.Errorf("Select(%d) = %d, want %d", i, got, k)
+		}
+	}
+}
+
+func TestTreeMapNavigation(t *testing.T) {
+	m := NewOrdered[int, string]()
+	if _, _, ok := m.Min(); ok {
+		t.Error("Min() on empty map should return false")
+	}
+	for _, k := range []int{10, 20, 30, 40} {
+		m.Put(k, "")
+	}
+
+	if k, _, _ := m.Min(); k != 10 {
+		t.Errorf("Min() = %d, want 10", k)
+	}
+	if k, _, _ := m.Max(); k != 40 {
+		t.Errorf("Max() = %d, want 40", k)
+	}
+	if k, _, ok := m.Floor(25); !ok || k != 20 {
+		t.Errorf("Floor(25) = %d, %v, want 20, true", k, ok)
+	}
+	if k, _, ok := m.Floor(30); !ok || k != 30 {
+		t.Errorf("Floor(30) = %d, %v, want 30, true", k, ok)
+	}
+	if _, _, ok := m.Floor(5); ok {
+		t.Error("Floor(5) should return false")
+	}
+	if k, _, ok := m.Ceiling(25); !ok || k != 30 {
+		t.Errorf("Ceiling(25) = %d, %v, want 30, true", k, ok)
+	}
+	if _, _, ok := m.Ceiling(45); ok {
+		t.Error("Ceiling(45) should return false")
+	}
+	if got := m.Rank(25); got != 2 {
+		t.Errorf("Rank(25) = %d, want 2", got)
+	}
+}
+
+func TestTreeMapIterators(t *testing.T) {
+	m := NewOrdered[int, int]()
+	for k := 0; k < 10; k++ {
+		m.Put(k, k*k)
+	}
+
+	var got []int
+	for k := range m.Range(3, 7) {
+		got = append(got, k)
+	}
+	if !slices.Equal(got, []int{3, 4, 5, 6}) {
+		t.Errorf("Range(3, 7) = %v, want [3 4 5 6]", got)
+	}
+
+	got = got[:0]
+	for k := range m.Backward() {
+		if k < 7 {
+			break
+		}
+		got = append(got, k)
+	}
+	if !slices.Equal(got, []int{9, 8, 7}) {
+		t.Errorf("Backward() = %v, want [9 8 7]", got)
+	}
+
+	if got := slices.Collect(m.Values()); got[3] != 9 {
+		t.Errorf("Values()[3] = %d, want 9", got[3])
+	}
+
+	m.Clear()
+	if m.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", m.Len())
+	}
+}
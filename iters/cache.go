@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iters
+
+import (
+	"iter"
+	"sync"
+	"sync/atomic"
+)
+
+type cache[V any] struct {
+	mu      sync.Mutex
+	cond    sync.Cond
+	seq     iter.Seq[V]
+	next    func() (V, bool)
+	stop    func()
+	buf     []V
+	pulling bool
+	done    bool
+}
+
+func newCache[V any](seq iter.Seq[V]) *cache[V] {
+	c := &cache[V]{seq: seq}
+	c.cond.L = &c.mu
+	return c
+}
+
+// get returns the value at index i, pulling it from the underlying sequence if it has not been recorded yet.
+func (c *cache[V]) get(i int) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for {
+		if i < len(c.buf) {
+			return c.buf[i], true
+		}
+		if c.done {
+			var zero V
+			return zero, false
+		}
+		if c.pulling {
+			c.cond.Wait()
+			continue
+		}
+
+		if c.next == nil {
+			c.next, c.stop = iter.Pull(c.seq)
+			c.seq = nil
+		}
+		v, ok := c.pull()
+		if !ok {
+			c.done = true
+			c.stop()
+			continue
+		}
+		c.buf = append(c.buf, v)
+	}
+}
+
+// pull pulls the next value without holding the lock, so traversals replaying recorded values
+// are not blocked by a slow underlying sequence. c.mu has to be held, and is held again on return,
+// even if the underlying sequence panics.
+func (c *cache[V]) pull() (V, bool) {
+	c.pulling = true
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		c.pulling = false
+		c.cond.Broadcast()
+	}()
+	return c.next()
+}
+
+func (c *cache[V]) release() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// The underlying sequence must not be stopped while it is being pulled from.
+	for c.pulling {
+		c.cond.Wait()
+	}
+	c.done = true
+	c.seq = nil
+	if c.stop != nil {
+		c.stop()
+	}
+}
+
+func (c *cache[V]) all(yield func(V) bool) {
+	for i := 0; ; i++ {
+		v, ok := c.get(i)
+		if !ok || !yield(v) {
+			return
+		}
+	}
+}
+
+// Cache returns a reusable iterator that records the values of seq on first traversal and
+// replays them on subsequent traversals. seq is only ever ranged over once, and only as far as
+// the furthest traversal of the returned iterator has advanced.
+//
+// The returned iterator may be ranged over by multiple goroutines simultaneously. Traversals replaying
+// recorded values do not wait for a traversal that is pulling the next value from seq.
+// The returned function releases seq; afterward, traversals only yield the values recorded so far.
+// If a traversal is pulling from seq, it waits for the pull to finish.
+// It has to be called if the returned iterator is not traversed to its end, and is safe to call multiple times.
+func Cache[V any](seq iter.Seq[V]) (iter.Seq[V], func()) {
+	c := newCache(seq)
+	return c.all, c.release
+}
+
+// Cache2 returns a reusable iterator that records the key-value pairs of seq on first traversal and
+// replays them on subsequent traversals.
+//
+// See Cache for details.
+func Cache2[K, V any](seq iter.Seq2[K, V]) (iter.Seq2[K, V], func()) {
+	c := newCache(MapLower(seq, func(k K, v V) kv[K, V] { return kv[K, V]{k, v} }))
+	return func(yield func(K, V) bool) {
+		c.all(func(e kv[K, V]) bool { return yield(e.k, e.v) })
+	}, c.release
+}
+
+// Once returns an iterator that yields the values of seq and panics if it is ranged over more than once.
+// It is meant to guard single-use sequences, such as sequences receiving from a channel, against accidental reuse.
+func Once[V any](seq iter.Seq[V]) iter.Seq[V] {
+	var used atomic.Bool
+	return func(yield func(V) bool) {
+		if used.Swap(true) {
+			panic("iters.Once: sequence ranged over more than once")
+		}
+		seq(yield)
+	}
+}
+
+// Once2 returns an iterator that yields the key-value pairs of seq and panics if it is ranged over more than once.
+// It is meant to guard single-use sequences against accidental reuse.
+func Once2[K, V any](seq iter.Seq2[K, V]) iter.Seq2[K, V] {
+	var used atomic.Bool
+	return func(yield func(K, V) bool) {
+		if used.Swap(true) {
+			panic("iters.Once2: sequence ranged over more than once")
+		}
+		seq(yield)
+	}
+}
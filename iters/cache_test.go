@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iters
+
+import (
+	"iter"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// onceSeq returns a single-use sequence of vs and a pointer to the number of values pulled from it.
+func onceSeq[V any](vs ...V) (iter.Seq[V], *int) {
+	var (
+		n    int
+		used bool
+	)
+	return func(yield func(V) bool) {
+		if used {
+			return
+		}
+		used = true
+		for _, v := range vs {
+			n++
+			if !yield(v) {
+				return
+			}
+		}
+	}, &n
+}
+
+func TestCache(t *testing.T) {
+	seq, pulled := onceSeq(1, 2, 3, 4)
+	cached, stop := Cache(seq)
+	defer stop()
+
+	if got, want := slices.Collect(Take(cached, 2)), []int{1, 2}; !slices.Equal(got, want) {
+		t.Errorf("Cache() = %v, want %v", got, want)
+	}
+	if *pulled != 2 {
+		t.Errorf("pulled = %d, want 2", *pulled)
+	}
+	for range 2 {
+		if got, want := slices.Collect(cached), []int{1, 2, 3, 4}; !slices.Equal(got, want) {
+			t.Errorf("Cache() = %v, want %v", got, want)
+		}
+	}
+	if *pulled != 4 {
+		t.Errorf("pulled = %d, want 4", *pulled)
+	}
+}
+
+func TestCacheStop(t *testing.T) {
+	seq, _ := onceSeq(1, 2, 3)
+	cached, stop := Cache(seq)
+	_, _ = First(cached)
+	stop()
+	stop()
+
+	if got, want := slices.Collect(cached), []int{1}; !slices.Equal(got, want) {
+		t.Errorf("Cache() after stop = %v, want %v", got, want)
+	}
+}
+
+func TestCacheConcurrent(t *testing.T) {
+	cached, stop := Cache(Range(0, 1000))
+	defer stop()
+
+	var (
+		wg   sync.WaitGroup
+		want = slices.Collect(Range(0, 1000))
+	)
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			n := 1000 - i*100
+			if got := slices.Collect(Take(cached, n)); !slices.Equal(got, want[:n]) {
+				t.Errorf("Cache() = %v, want %v", got, want[:n])
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestCacheReplayWhilePulling(t *testing.T) {
+	var (
+		in      = make(chan int)
+		pulling = make(chan struct{})
+	)
+	cached, stop := Cache(func(yield func(int) bool) {
+		for {
+			pulling <- struct{}{}
+			v, ok := <-in
+			if !ok || !yield(v) {
+				return
+			}
+		}
+	})
+	defer stop()
+
+	first := make(chan int)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for v := range cached {
+			first <- v
+		}
+	}()
+
+	<-pulling
+	in <- 1
+	if v := <-first; v != 1 {
+		t.Fatalf("first traversal = %d, want 1", v)
+	}
+	// The first traversal is now blocked pulling the next value from in.
+	<-pulling
+
+	if v, ok := First(cached); !ok || v != 1 {
+		t.Errorf("First() = %d, %v, want 1, true", v, ok)
+	}
+
+	close(in)
+	<-done
+}
+
+func TestCache2(t *testing.T) {
+	cached, stop := Cache2(Enumerate[int](Once(Of("a", "b"))))
+	defer stop()
+
+	want := []KV[int, string]{{0, "a"}, {1, "b"}}
+	for range 2 {
+		if got := collect2(cached); !slices.Equal(got, want) {
+			t.Errorf("Cache2() = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestOnce(t *testing.T) {
+	seq := Once(Of(1, 2))
+	if got, want := slices.Collect(seq), []int{1, 2}; !slices.Equal(got, want) {
+		t.Errorf("Once() = %v, want %v", got, want)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Once() did not panic on second range")
+		}
+	}()
+	for range seq {
+	}
+}
+
+func TestOnce2(t *testing.T) {
+	seq := Once2(Singleton2(1, "a"))
+	_ = collect2(seq)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Once2() did not panic on second range")
+		}
+	}()
+	_ = collect2(seq)
+}
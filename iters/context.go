@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iters
+
+import (
+	"context"
+	"iter"
+)
+
+// WithContext returns a new iterator that yields the values from seq with a nil error.
+// If ctx is done before seq starts or between two values, it yields the zero value and ctx.Err() and stops.
+//
+// WithContext cannot interrupt seq while it is producing a value; seq itself has to return
+// in time for the cancellation to be observed.
+func WithContext[V any](ctx context.Context, seq iter.Seq[V]) iter.Seq2[V, error] {
+	return TryWithContext(ctx, LiftSuccess(seq))
+}
+
+// TryWithContext returns a new iterator that yields the values and errors from seq.
+// If ctx is done before seq starts or between two values, it yields the zero value and ctx.Err() and stops.
+//
+// See WithContext for limitations.
+func TryWithContext[V any](ctx context.Context, seq iter.Seq2[V, error]) iter.Seq2[V, error] {
+	return func(yield func(V, error) bool) {
+		if err := ctx.Err(); err != nil {
+			var zero V
+			yield(zero, err)
+			return
+		}
+
+		for v, err := range seq {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				var zero V
+				yield(zero, ctxErr)
+				return
+			}
+
+			if !yield(v, err) {
+				return
+			}
+		}
+	}
+}
+
+// FromNextContext returns a new iterator that yields values from a function.
+// next is called with ctx until it returns false.
+// If ctx is done, it yields the zero value and ctx.Err() and stops.
+func FromNextContext[V any](ctx context.Context, next func(context.Context) (V, bool)) iter.Seq2[V, error] {
+	return func(yield func(V, error) bool) {
+		for {
+			if err := ctx.Err(); err != nil {
+				var zero V
+				yield(zero, err)
+				return
+			}
+
+			v, ok := next(ctx)
+			if !ok {
+				if err := ctx.Err(); err != nil {
+					var zero V
+					yield(zero, err)
+				}
+				return
+			}
+
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+// RepeatContext returns a new iterator that yields the given value n times.
+// If ctx is done, it yields the zero value and ctx.Err() and stops.
+func RepeatContext[V any](ctx context.Context, v V, n int) iter.Seq2[V, error] {
+	if n < 0 {
+		panic("iters.RepeatContext: negative n")
+	}
+	return WithContext(ctx, Repeat(v, n))
+}
+
+// CycleContext cycles over the given sequence by repeatedly calling it until ctx is done.
+// If ctx is done, it yields the zero value and ctx.Err() and stops.
+// If a round over seq yields no values, CycleContext stops instead of spinning until ctx is done.
+//
+// CycleContext will not work with non-reusable iterators, as it does not cache.
+func CycleContext[V any](ctx context.Context, seq iter.Seq[V]) iter.Seq2[V, error] {
+	return func(yield func(V, error) bool) {
+		for {
+			var yielded bool
+			for v, err := range WithContext(ctx, seq) {
+				if !yield(v, err) || err != nil {
+					return
+				}
+				yielded = true
+			}
+			if !yielded {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iters
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestWithContext(t *testing.T) {
+	got := collect2(WithContext(context.Background(), Of(1, 2)))
+	want := []KV[int, error]{{1, nil}, {2, nil}}
+	if !slices.Equal(got, want) {
+		t.Errorf("WithContext() = %v, want %v", got, want)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	got = collect2(WithContext(ctx, Tap(Range(0, 10), func(v int) {
+		if v == 2 {
+			cancel()
+		}
+	})))
+	want = []KV[int, error]{{0, nil}, {1, nil}, {0, context.Canceled}}
+	if !slices.Equal(got, want) {
+		t.Errorf("WithContext() = %v, want %v", got, want)
+	}
+
+	got = collect2(WithContext(ctx, Empty[int]()))
+	want = []KV[int, error]{{0, context.Canceled}}
+	if !slices.Equal(got, want) {
+		t.Errorf("WithContext() = %v, want %v", got, want)
+	}
+}
+
+func TestTryWithContext(t *testing.T) {
+	seq := Concat2(Singleton2(1, error(nil)), Singleton2(0, errTest))
+	got := collect2(TryWithContext(context.Background(), seq))
+	want := []KV[int, error]{{1, nil}, {0, errTest}}
+	if !slices.Equal(got, want) {
+		t.Errorf("TryWithContext() = %v, want %v", got, want)
+	}
+}
+
+func TestFromNextContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var i int
+	next := func(ctx context.Context) (int, bool) {
+		i++
+		if i == 3 {
+			cancel()
+			return 0, false
+		}
+		return i, true
+	}
+	got := collect2(FromNextContext(ctx, next))
+	want := []KV[int, error]{{1, nil}, {2, nil}, {0, context.Canceled}}
+	if !slices.Equal(got, want) {
+		t.Errorf("FromNextContext() = %v, want %v", got, want)
+	}
+
+	i = 0
+	got = collect2(FromNextContext(context.Background(), func(context.Context) (int, bool) {
+		i++
+		return i, i < 3
+	}))
+	want = []KV[int, error]{{1, nil}, {2, nil}}
+	if !slices.Equal(got, want) {
+		t.Errorf("FromNextContext() = %v, want %v", got, want)
+	}
+}
+
+func TestRepeatContext(t *testing.T) {
+	got := collect2(RepeatContext(context.Background(), "a", 2))
+	want := []KV[string, error]{{"a", nil}, {"a", nil}}
+	if !slices.Equal(got, want) {
+		t.Errorf("RepeatContext() = %v, want %v", got, want)
+	}
+}
+
+func TestCycleContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		got []int
+		err error
+	)
+	for v, e := range CycleContext(ctx, Of(1, 2)) {
+		if e != nil {
+			err = e
+			break
+		}
+		got = append(got, v)
+		if len(got) == 5 {
+			cancel()
+		}
+	}
+	if want := []int{1, 2, 1, 2, 1}; !slices.Equal(got, want) {
+		t.Errorf("CycleContext() = %v, want %v", got, want)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("CycleContext() error = %v, want %v", err, context.Canceled)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	if got := collect2(CycleContext(ctx, Empty[int]())); len(got) != 1 || got[0].V != context.Canceled {
+		t.Errorf("CycleContext() = %v, want single context error", got)
+	}
+
+	if got := collect2(CycleContext(context.Background(), Empty[int]())); len(got) != 0 {
+		t.Errorf("CycleContext() = %v, want empty", got)
+	}
+}
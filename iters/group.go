@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iters
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+
+	"spheric.cloud/xstd/set"
+)
+
+// ErrDuplicateKey is returned by IndexByUnique and TryIndexByUnique if two values map to the same key.
+var ErrDuplicateKey = errors.New("iters: duplicate key")
+
+// GroupBy groups the values in seq by the key returned by f.
+// The values of each group retain the order of seq.
+func GroupBy[V any, K comparable](seq iter.Seq[V], f func(V) K) map[K][]V {
+	res := make(map[K][]V)
+	for v := range seq {
+		k := f(v)
+		res[k] = append(res[k], v)
+	}
+	return res
+}
+
+// TryGroupBy groups the values in seq by the key returned by f.
+// If seq contains an error, it returns nil and the error.
+func TryGroupBy[V any, K comparable](seq iter.Seq2[V, error], f func(V) K) (map[K][]V, error) {
+	res := make(map[K][]V)
+	for v, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		k := f(v)
+		res[k] = append(res[k], v)
+	}
+	return res, nil
+}
+
+// GroupBySet groups the values in seq into sets by the key returned by f.
+func GroupBySet[V, K comparable](seq iter.Seq[V], f func(V) K) map[K]set.Set[V] {
+	res := make(map[K]set.Set[V])
+	for v := range seq {
+		insertGroupSet(res, f(v), v)
+	}
+	return res
+}
+
+// TryGroupBySet groups the values in seq into sets by the key returned by f.
+// If seq contains an error, it returns nil and the error.
+func TryGroupBySet[V, K comparable](seq iter.Seq2[V, error], f func(V) K) (map[K]set.Set[V], error) {
+	res := make(map[K]set.Set[V])
+	for v, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		insertGroupSet(res, f(v), v)
+	}
+	return res, nil
+}
+
+func insertGroupSet[V, K comparable](m map[K]set.Set[V], k K, v V) {
+	s, ok := m[k]
+	if !ok {
+		s = set.New[V]()
+		m[k] = s
+	}
+	s.Insert(v)
+}
+
+// PartitionBy splits the values in seq into those for which f returns true and those for which it returns false.
+// Both slices retain the order of seq.
+func PartitionBy[V any](seq iter.Seq[V], f func(V) bool) (yes, no []V) {
+	for v := range seq {
+		if f(v) {
+			yes = append(yes, v)
+		} else {
+			no = append(no, v)
+		}
+	}
+	return yes, no
+}
+
+// TryPartitionBy splits the values in seq into those for which f returns true and those for which it returns false.
+// If seq contains an error, it returns nil slices and the error.
+func TryPartitionBy[V any](seq iter.Seq2[V, error], f func(V) bool) (yes, no []V, err error) {
+	for v, err := range seq {
+		if err != nil {
+			return nil, nil, err
+		}
+		if f(v) {
+			yes = append(yes, v)
+		} else {
+			no = append(no, v)
+		}
+	}
+	return yes, no, nil
+}
+
+// CountBy counts the values in seq per key returned by f.
+func CountBy[V any, K comparable](seq iter.Seq[V], f func(V) K) map[K]int {
+	res := make(map[K]int)
+	for v := range seq {
+		res[f(v)]++
+	}
+	return res
+}
+
+// TryCountBy counts the values in seq per key returned by f.
+// If seq contains an error, it returns nil and the error.
+func TryCountBy[V any, K comparable](seq iter.Seq2[V, error], f func(V) K) (map[K]int, error) {
+	res := make(map[K]int)
+	for v, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		res[f(v)]++
+	}
+	return res, nil
+}
+
+// IndexBy maps each value in seq by the key returned by f.
+// If multiple values map to the same key, the last one wins.
+func IndexBy[V any, K comparable](seq iter.Seq[V], f func(V) K) map[K]V {
+	res := make(map[K]V)
+	for v := range seq {
+		res[f(v)] = v
+	}
+	return res
+}
+
+// TryIndexBy maps each value in seq by the key returned by f.
+// If multiple values map to the same key, the last one wins.
+// If seq contains an error, it returns nil and the error.
+func TryIndexBy[V any, K comparable](seq iter.Seq2[V, error], f func(V) K) (map[K]V, error) {
+	res := make(map[K]V)
+	for v, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		res[f(v)] = v
+	}
+	return res, nil
+}
+
+// IndexByUnique maps each value in seq by the key returned by f.
+// If multiple values map to the same key, it returns nil and an error wrapping ErrDuplicateKey.
+func IndexByUnique[V any, K comparable](seq iter.Seq[V], f func(V) K) (map[K]V, error) {
+	return TryIndexByUnique(LiftSuccess(seq), f)
+}
+
+// TryIndexByUnique maps each value in seq by the key returned by f.
+// If multiple values map to the same key, it returns nil and an error wrapping ErrDuplicateKey.
+// If seq contains an error, it returns nil and the error.
+func TryIndexByUnique[V any, K comparable](seq iter.Seq2[V, error], f func(V) K) (map[K]V, error) {
+	res := make(map[K]V)
+	for v, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		k := f(v)
+		if _, ok := res[k]; ok {
+			return nil, fmt.Errorf("%w: %v", ErrDuplicateKey, k)
+		}
+		res[k] = v
+	}
+	return res, nil
+}
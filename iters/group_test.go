@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iters
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+
+	"spheric.cloud/xstd/set"
+)
+
+func parity(v int) string {
+	if v%2 == 0 {
+		return "even"
+	}
+	return "odd"
+}
+
+func TestGroupBy(t *testing.T) {
+	got := GroupBy(Range(0, 6), parity)
+	want := map[string][]int{"even": {0, 2, 4}, "odd": {1, 3, 5}}
+	if !maps.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("GroupBy() = %v, want %v", got, want)
+	}
+
+	if _, err := TryGroupBy(Concat2(LiftSuccess(Of(1)), Singleton2(0, errTest)), parity); err != errTest {
+		t.Errorf("TryGroupBy() error = %v, want %v", err, errTest)
+	}
+}
+
+func TestGroupBySet(t *testing.T) {
+	got := GroupBySet(Of(1, 2, 3, 1, 3), parity)
+	want := map[string]set.Set[int]{"even": set.New(2), "odd": set.New(1, 3)}
+	if !maps.EqualFunc(got, want, maps.Equal) {
+		t.Errorf("GroupBySet() = %v, want %v", got, want)
+	}
+
+	got, err := TryGroupBySet(LiftSuccess(Of(2, 2)), parity)
+	if err != nil || !maps.EqualFunc(got, map[string]set.Set[int]{"even": set.New(2)}, maps.Equal) {
+		t.Errorf("TryGroupBySet() = %v, %v, want map[even:{2}], nil", got, err)
+	}
+}
+
+func TestPartitionBy(t *testing.T) {
+	yes, no := PartitionBy(Range(0, 5), func(v int) bool { return v < 2 })
+	if !slices.Equal(yes, []int{0, 1}) || !slices.Equal(no, []int{2, 3, 4}) {
+		t.Errorf("PartitionBy() = %v, %v, want [0 1], [2 3 4]", yes, no)
+	}
+
+	if _, _, err := TryPartitionBy(Concat2(LiftSuccess(Of(1)), Singleton2(0, errTest)), func(int) bool { return true }); err != errTest {
+		t.Errorf("TryPartitionBy() error = %v, want %v", err, errTest)
+	}
+}
+
+func TestCountBy(t *testing.T) {
+	got := CountBy(Range(0, 5), parity)
+	want := map[string]int{"even": 3, "odd": 2}
+	if !maps.Equal(got, want) {
+		t.Errorf("CountBy() = %v, want %v", got, want)
+	}
+
+	got, err := TryCountBy(LiftSuccess(Of(1)), parity)
+	if err != nil || !maps.Equal(got, map[string]int{"odd": 1}) {
+		t.Errorf("TryCountBy() = %v, %v, want map[odd:1], nil", got, err)
+	}
+}
+
+func TestIndexBy(t *testing.T) {
+	got := IndexBy(Of("a", "bb", "cc"), func(s string) int { return len(s) })
+	want := map[int]string{1: "a", 2: "cc"}
+	if !maps.Equal(got, want) {
+		t.Errorf("IndexBy() = %v, want %v", got, want)
+	}
+
+	got, err := TryIndexBy(LiftSuccess(Of("a")), func(s string) int { return len(s) })
+	if err != nil || !maps.Equal(got, map[int]string{1: "a"}) {
+		t.Errorf("TryIndexBy() = %v, %v, want map[1:a], nil", got, err)
+	}
+}
+
+func TestIndexByUnique(t *testing.T) {
+	got, err := IndexByUnique(Of("a", "bb"), func(s string) int { return len(s) })
+	if err != nil || !maps.Equal(got, map[int]string{1: "a", 2: "bb"}) {
+		t.Errorf("IndexByUnique() = %v, %v, want map[1:a 2:bb], nil", got, err)
+	}
+
+	_, err = IndexByUnique(Of("a", "bb", "cc"), func(s string) int { return len(s) })
+	if !errors.Is(err, ErrDuplicateKey) {
+		t.Errorf("IndexByUnique() error = %v, want %v", err, ErrDuplicateKey)
+	}
+
+	_, err = TryIndexByUnique(Concat2(LiftSuccess(Of("a")), Singleton2("", errTest)), func(s string) int { return len(s) })
+	if err != errTest {
+		t.Errorf("TryIndexByUnique() error = %v, want %v", err, errTest)
+	}
+}
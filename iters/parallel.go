@@ -0,0 +1,225 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iters
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"sync"
+)
+
+type parResult[V any] struct {
+	v   V
+	err error
+}
+
+// ParMap returns a new iterator that yields the results of calling f on each value from seq,
+// running at most n calls of f concurrently. The results are yielded in the order of seq.
+//
+// seq is consumed in a separate goroutine. If the consumer stops early or ctx is cancelled,
+// the context passed to f is cancelled and ParMap returns once all started goroutines have exited.
+// If ctx is cancelled, the iterator stops without yielding further values.
+func ParMap[VIn, VOut any](ctx context.Context, seq iter.Seq[VIn], n int, f func(context.Context, VIn) VOut) iter.Seq[VOut] {
+	return parMapSuccess("ParMap", parMapOrdered, ctx, seq, n, f)
+}
+
+// ParMapUnordered returns a new iterator that yields the results of calling f on each value from seq,
+// running at most n calls of f concurrently. The results are yielded as soon as they are available,
+// so their order is unspecified.
+//
+// Cancellation behaves as documented on ParMap.
+func ParMapUnordered[VIn, VOut any](ctx context.Context, seq iter.Seq[VIn], n int, f func(context.Context, VIn) VOut) iter.Seq[VOut] {
+	return parMapSuccess("ParMapUnordered", parMapUnordered, ctx, seq, n, f)
+}
+
+// TryParMapErr returns a new iterator that yields the results of calling f on each value from seq,
+// running at most n calls of f concurrently. The results are yielded in the order of seq.
+// If seq contains an error, or f returns an error, it yields the error.
+// If ctx is cancelled, it yields the context error and stops.
+//
+// Cancellation behaves as documented on ParMap.
+func TryParMapErr[VIn, VOut any](ctx context.Context, seq iter.Seq2[VIn, error], n int, f func(context.Context, VIn) (VOut, error)) iter.Seq2[VOut, error] {
+	checkParN("TryParMapErr", n)
+	return parMapOrdered(ctx, seq, n, f)
+}
+
+// TryParMapErrUnordered returns a new iterator that yields the results of calling f on each value from seq,
+// running at most n calls of f concurrently. The results are yielded as soon as they are available,
+// so their order is unspecified.
+// If seq contains an error, or f returns an error, it yields the error.
+// If ctx is cancelled, it yields the context error and stops.
+//
+// Cancellation behaves as documented on ParMap.
+func TryParMapErrUnordered[VIn, VOut any](ctx context.Context, seq iter.Seq2[VIn, error], n int, f func(context.Context, VIn) (VOut, error)) iter.Seq2[VOut, error] {
+	checkParN("TryParMapErrUnordered", n)
+	return parMapUnordered(ctx, seq, n, f)
+}
+
+func checkParN(name string, n int) {
+	if n <= 0 {
+		panic(fmt.Sprintf("iters.%s: n must be > 0", name))
+	}
+}
+
+func parMapSuccess[VIn, VOut any](
+	name string,
+	parMap func(context.Context, iter.Seq2[VIn, error], int, func(context.Context, VIn) (VOut, error)) iter.Seq2[VOut, error],
+	ctx context.Context,
+	seq iter.Seq[VIn],
+	n int,
+	f func(context.Context, VIn) VOut,
+) iter.Seq[VOut] {
+	checkParN(name, n)
+	seq2 := parMap(ctx, LiftSuccess(seq), n, func(ctx context.Context, vIn VIn) (VOut, error) {
+		return f(ctx, vIn), nil
+	})
+	return func(yield func(VOut) bool) {
+		for vOut, err := range seq2 {
+			if err != nil || !yield(vOut) {
+				return
+			}
+		}
+	}
+}
+
+func parMapOrdered[VIn, VOut any](ctx context.Context, seq iter.Seq2[VIn, error], n int, f func(context.Context, VIn) (VOut, error)) iter.Seq2[VOut, error] {
+	return func(yield func(VOut, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		var wg sync.WaitGroup
+		defer wg.Wait()
+		defer cancel()
+
+		var (
+			// pending holds one result slot per input value, in input order.
+			pending = make(chan chan parResult[VOut], n)
+			sem     = make(chan struct{}, n)
+		)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(pending)
+
+			for vIn, err := range seq {
+				res := make(chan parResult[VOut], 1)
+				if err != nil {
+					res <- parResult[VOut]{err: err}
+				} else {
+					select {
+					case <-ctx.Done():
+						return
+					case sem <- struct{}{}:
+					}
+
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						defer func() { <-sem }()
+						vOut, err := f(ctx, vIn)
+						res <- parResult[VOut]{vOut, err}
+					}()
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case pending <- res:
+				}
+			}
+		}()
+
+		for res := range pending {
+			select {
+			case <-ctx.Done():
+				var zero VOut
+				yield(zero, ctx.Err())
+				return
+			case r := <-res:
+				if !yield(r.v, r.err) {
+					return
+				}
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			var zero VOut
+			yield(zero, err)
+		}
+	}
+}
+
+func parMapUnordered[VIn, VOut any](ctx context.Context, seq iter.Seq2[VIn, error], n int, f func(context.Context, VIn) (VOut, error)) iter.Seq2[VOut, error] {
+	return func(yield func(VOut, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		var (
+			wg      sync.WaitGroup
+			results = make(chan parResult[VOut], n)
+			sem     = make(chan struct{}, n)
+		)
+		defer func() {
+			cancel()
+			for range results {
+			}
+		}()
+
+		send := func(r parResult[VOut]) {
+			select {
+			case <-ctx.Done():
+			case results <- r:
+			}
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for vIn, err := range seq {
+				if err != nil {
+					select {
+					case <-ctx.Done():
+						return
+					case results <- parResult[VOut]{err: err}:
+					}
+					continue
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case sem <- struct{}{}:
+				}
+
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					defer func() { <-sem }()
+					vOut, err := f(ctx, vIn)
+					send(parResult[VOut]{vOut, err})
+				}()
+			}
+		}()
+		go func() {
+			wg.Wait()
+			close(results)
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				var zero VOut
+				yield(zero, ctx.Err())
+				return
+			case r, ok := <-results:
+				if !ok {
+					if err := ctx.Err(); err != nil {
+						var zero VOut
+						yield(zero, err)
+					}
+					return
+				}
+				if !yield(r.v, r.err) {
+					return
+				}
+			}
+		}
+	}
+}
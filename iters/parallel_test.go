@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iters
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParMap(t *testing.T) {
+	var active, maxActive atomic.Int32
+	f := func(ctx context.Context, v int) int {
+		cur := active.Add(1)
+		defer active.Add(-1)
+		for {
+			old := maxActive.Load()
+			if cur <= old || maxActive.CompareAndSwap(old, cur) {
+				break
+			}
+		}
+		time.Sleep(time.Duration(10-v) * time.Millisecond)
+		return v * 2
+	}
+
+	got := slices.Collect(ParMap(context.Background(), Range(0, 10), 3, f))
+	want := []int{0, 2, 4, 6, 8, 10, 12, 14, 16, 18}
+	if !slices.Equal(got, want) {
+		t.Errorf("ParMap() = %v, want %v", got, want)
+	}
+	if m := maxActive.Load(); m > 3 {
+		t.Errorf("ParMap() max concurrency = %d, want <= 3", m)
+	}
+}
+
+func TestParMapUnordered(t *testing.T) {
+	got := slices.Collect(ParMapUnordered(context.Background(), Range(0, 10), 4, func(ctx context.Context, v int) int {
+		return v * 2
+	}))
+	slices.Sort(got)
+	want := []int{0, 2, 4, 6, 8, 10, 12, 14, 16, 18}
+	if !slices.Equal(got, want) {
+		t.Errorf("ParMapUnordered() = %v, want %v", got, want)
+	}
+}
+
+func TestParMapBreak(t *testing.T) {
+	var active atomic.Int32
+	f := func(ctx context.Context, v int) int {
+		active.Add(1)
+		defer active.Add(-1)
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Duration(v) * time.Millisecond):
+		}
+		return v
+	}
+
+	for _, par := range []func(context.Context, int) []int{
+		func(ctx context.Context, n int) []int {
+			return slices.Collect(Take(ParMap(ctx, Range(0, n), 4, f), 2))
+		},
+		func(ctx context.Context, n int) []int {
+			return slices.Collect(Take(ParMapUnordered(ctx, Range(0, n), 4, f), 2))
+		},
+	} {
+		got := par(context.Background(), 1000)
+		if len(got) != 2 {
+			t.Errorf("len = %d, want 2", len(got))
+		}
+		if a := active.Load(); a != 0 {
+			t.Errorf("active workers after break = %d, want 0", a)
+		}
+	}
+}
+
+func TestParMapCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var errs []error
+	for _, err := range TryParMapErr(ctx, LiftSuccess(Range(0, 1000)), 2, func(ctx context.Context, v int) (int, error) {
+		if v == 5 {
+			cancel()
+		}
+		return v, nil
+	}) {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], context.Canceled) {
+		t.Errorf("TryParMapErr() errors = %v, want [%v]", errs, context.Canceled)
+	}
+}
+
+func TestTryParMapErr(t *testing.T) {
+	seq := MapLift(Range(0, 6), func(v int) (int, error) {
+		if v == 1 {
+			return 0, errTest
+		}
+		return v, nil
+	})
+	f := func(ctx context.Context, v int) (int, error) {
+		if v == 4 {
+			return 0, errTest
+		}
+		return v * 10, nil
+	}
+
+	got := collect2(TryParMapErr(context.Background(), seq, 2, f))
+	want := []KV[int, error]{{0, nil}, {0, errTest}, {20, nil}, {30, nil}, {0, errTest}, {50, nil}}
+	if !slices.Equal(got, want) {
+		t.Errorf("TryParMapErr() = %v, want %v", got, want)
+	}
+
+	var nErrs int
+	for _, err := range TryParMapErrUnordered(context.Background(), seq, 2, f) {
+		if err != nil {
+			nErrs++
+		}
+	}
+	if nErrs != 2 {
+		t.Errorf("TryParMapErrUnordered() errors = %d, want 2", nErrs)
+	}
+}
+
+func TestTryParMapErrUnorderedBreak(t *testing.T) {
+	var pulled atomic.Int32
+	errs := func(yield func(int, error) bool) {
+		for {
+			pulled.Add(1)
+			if !yield(0, errTest) {
+				return
+			}
+		}
+	}
+
+	for _, err := range TryParMapErrUnordered(context.Background(), errs, 2, func(ctx context.Context, v int) (int, error) {
+		return v, nil
+	}) {
+		if err != errTest {
+			t.Errorf("TryParMapErrUnordered() error = %v, want %v", err, errTest)
+		}
+		break
+	}
+	if p := pulled.Load(); p > 10 {
+		t.Errorf("TryParMapErrUnordered() pulled %d values after break, want at most 10", p)
+	}
+}
@@ -0,0 +1,207 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iters
+
+import (
+	"iter"
+	"slices"
+)
+
+// Peekable is a pull-style iterator with lookahead and push back.
+// It is created with NewPeekable and has to be stopped using Stop once it is no longer used.
+//
+// A Peekable must not be used by multiple goroutines simultaneously.
+type Peekable[V any] struct {
+	next func() (V, bool)
+	stop func()
+	// buf holds values that have been pulled from next or pushed back via Unread,
+	// but not yet been returned by Next. buf[0] is the value returned next.
+	buf     []V
+	done    bool
+	stopped bool
+}
+
+// NewPeekable creates a new Peekable that pulls values from seq.
+func NewPeekable[V any](seq iter.Seq[V]) *Peekable[V] {
+	next, stop := iter.Pull(seq)
+	return &Peekable[V]{next: next, stop: stop}
+}
+
+// fill pulls values until buf contains at least n values or the underlying sequence is exhausted.
+// It reports whether buf contains at least n values.
+func (p *Peekable[V]) fill(n int) bool {
+	for len(p.buf) < n && !p.done {
+		v, ok := p.next()
+		if !ok {
+			p.done = true
+			break
+		}
+		p.buf = append(p.buf, v)
+	}
+	return len(p.buf) >= n
+}
+
+// Peek returns the next value without consuming it.
+// The second return value is false if there are no more values.
+func (p *Peekable[V]) Peek() (V, bool) {
+	if !p.fill(1) {
+		var zero V
+		return zero, false
+	}
+	return p.buf[0], true
+}
+
+// PeekN returns up to the next n values without consuming them.
+// The returned slice is shorter than n if there are fewer values left.
+func (p *Peekable[V]) PeekN(n int) []V {
+	if n < 0 {
+		panic("iters.Peekable.PeekN: negative n")
+	}
+	p.fill(n)
+	return slices.Clone(p.buf[:min(n, len(p.buf))])
+}
+
+// Next consumes and returns the next value.
+// The second return value is false if there are no more values.
+func (p *Peekable[V]) Next() (V, bool) {
+	if !p.fill(1) {
+		var zero V
+		return zero, false
+	}
+	v := p.buf[0]
+	var zero V
+	p.buf[0] = zero // Zero the value to help GC
+	p.buf = p.buf[1:]
+	return v, true
+}
+
+// NextIf consumes and returns the next value if f returns true for it.
+// The second return value is false if there are no more values or f returned false.
+func (p *Peekable[V]) NextIf(f func(V) bool) (V, bool) {
+	v, ok := p.Peek()
+	if !ok || !f(v) {
+		var zero V
+		return zero, false
+	}
+	return p.Next()
+}
+
+// Unread pushes v back, so that it is returned by the next call to Peek or Next.
+// Multiple values pushed back are returned in reverse order of their Unread calls.
+// Unread has no effect after Stop.
+func (p *Peekable[V]) Unread(v V) {
+	if p.stopped {
+		return
+	}
+	p.buf = slices.Insert(p.buf, 0, v)
+}
+
+// Stop stops the underlying iterator and discards all buffered values.
+// After Stop, Peek and Next report no more values. It is valid to call Stop multiple times.
+func (p *Peekable[V]) Stop() {
+	p.stop()
+	p.buf = nil
+	p.done = true
+	p.stopped = true
+}
+
+// Seq returns an iterator that consumes the remaining values.
+// Stopping the returned iterator early does not stop p, so consumption can be resumed afterward.
+func (p *Peekable[V]) Seq() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for {
+			v, ok := p.Next()
+			if !ok || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+type kv[K, V any] struct {
+	k K
+	v V
+}
+
+// Peekable2 is a pull-style iterator of key-value pairs with lookahead and push back.
+// It is created with NewPeekable2 and has to be stopped using Stop once it is no longer used.
+//
+// A Peekable2 must not be used by multiple goroutines simultaneously.
+type Peekable2[K, V any] struct {
+	p *Peekable[kv[K, V]]
+}
+
+// NewPeekable2 creates a new Peekable2 that pulls key-value pairs from seq.
+func NewPeekable2[K, V any](seq iter.Seq2[K, V]) *Peekable2[K, V] {
+	return &Peekable2[K, V]{
+		p: NewPeekable(MapLower(seq, func(k K, v V) kv[K, V] { return kv[K, V]{k, v} })),
+	}
+}
+
+// Peek returns the next key-value pair without consuming it.
+// The third return value is false if there are no more key-value pairs.
+func (p *Peekable2[K, V]) Peek() (K, V, bool) {
+	e, ok := p.p.Peek()
+	return e.k, e.v, ok
+}
+
+// PeekN returns up to the next n key-value pairs without consuming them,
+// as two slices of equal length.
+// The returned slices are shorter than n if there are fewer key-value pairs left.
+func (p *Peekable2[K, V]) PeekN(n int) ([]K, []V) {
+	if n < 0 {
+		panic("iters.Peekable2.PeekN: negative n")
+	}
+	p.p.fill(n)
+	var (
+		es = p.p.buf[:min(n, len(p.p.buf))]
+		ks = make([]K, len(es))
+		vs = make([]V, len(es))
+	)
+	for i, e := range es {
+		ks[i] = e.k
+		vs[i] = e.v
+	}
+	return ks, vs
+}
+
+// Next consumes and returns the next key-value pair.
+// The third return value is false if there are no more key-value pairs.
+func (p *Peekable2[K, V]) Next() (K, V, bool) {
+	e, ok := p.p.Next()
+	return e.k, e.v, ok
+}
+
+// NextIf consumes and returns the next key-value pair if f returns true for it.
+// The third return value is false if there are no more key-value pairs or f returned false.
+func (p *Peekable2[K, V]) NextIf(f func(K, V) bool) (K, V, bool) {
+	e, ok := p.p.NextIf(func(e kv[K, V]) bool { return f(e.k, e.v) })
+	return e.k, e.v, ok
+}
+
+// Unread pushes the key-value pair back, so that it is returned by the next call to Peek or Next.
+// Multiple key-value pairs pushed back are returned in reverse order of their Unread calls.
+// Unread has no effect after Stop.
+func (p *Peekable2[K, V]) Unread(k K, v V) {
+	p.p.Unread(kv[K, V]{k, v})
+}
+
+// Stop stops the underlying iterator and discards all buffered key-value pairs.
+// It is valid to call Stop multiple times.
+func (p *Peekable2[K, V]) Stop() {
+	p.p.Stop()
+}
+
+// Seq returns an iterator that consumes the remaining key-value pairs.
+// Stopping the returned iterator early does not stop p, so consumption can be resumed afterward.
+func (p *Peekable2[K, V]) Seq() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for {
+			k, v, ok := p.Next()
+			if !ok || !yield(k, v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iters
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPeekable(t *testing.T) {
+	p := NewPeekable(Range(0, 5))
+	defer p.Stop()
+
+	if v, ok := p.Peek(); !ok || v != 0 {
+		t.Errorf("Peek() = %d, %v, want 0, true", v, ok)
+	}
+	if got, want := p.PeekN(3), []int{0, 1, 2}; !slices.Equal(got, want) {
+		t.Errorf("PeekN(3) = %v, want %v", got, want)
+	}
+	if v, ok := p.Next(); !ok || v != 0 {
+		t.Errorf("Next() = %d, %v, want 0, true", v, ok)
+	}
+	if v, ok := p.NextIf(func(v int) bool { return v > 1 }); ok {
+		t.Errorf("NextIf() = %d, %v, want 0, false", v, ok)
+	}
+	if v, ok := p.NextIf(func(v int) bool { return v == 1 }); !ok || v != 1 {
+		t.Errorf("NextIf() = %d, %v, want 1, true", v, ok)
+	}
+
+	p.Unread(10)
+	p.Unread(11)
+	if got, want := p.PeekN(10), []int{11, 10, 2, 3, 4}; !slices.Equal(got, want) {
+		t.Errorf("PeekN(10) = %v, want %v", got, want)
+	}
+
+	for v := range p.Seq() {
+		if v == 10 {
+			break
+		}
+	}
+	if got, want := slices.Collect(p.Seq()), []int{2, 3, 4}; !slices.Equal(got, want) {
+		t.Errorf("Seq() = %v, want %v", got, want)
+	}
+	if v, ok := p.Next(); ok {
+		t.Errorf("Next() = %d, %v, want 0, false", v, ok)
+	}
+
+	p.Unread(42)
+	if v, ok := p.Next(); !ok || v != 42 {
+		t.Errorf("Next() = %d, %v, want 42, true", v, ok)
+	}
+}
+
+func TestPeekableStop(t *testing.T) {
+	var pulled int
+	p := NewPeekable(Tap(Range(0, 100), func(int) { pulled++ }))
+	p.PeekN(2)
+	p.Stop()
+	p.Stop()
+
+	if v, ok := p.Peek(); ok {
+		t.Errorf("Peek() = %d, %v, want 0, false", v, ok)
+	}
+	p.Unread(42)
+	if v, ok := p.Next(); ok {
+		t.Errorf("Next() after Unread = %d, %v, want 0, false", v, ok)
+	}
+	if pulled != 2 {
+		t.Errorf("pulled = %d, want 2", pulled)
+	}
+}
+
+func TestPeekable2(t *testing.T) {
+	p := NewPeekable2(Enumerate[int](Of("a", "b", "c")))
+	defer p.Stop()
+
+	if k, v, ok := p.Peek(); !ok || k != 0 || v != "a" {
+		t.Errorf("Peek() = %d, %q, %v, want 0, \"a\", true", k, v, ok)
+	}
+	ks, vs := p.PeekN(2)
+	if !slices.Equal(ks, []int{0, 1}) || !slices.Equal(vs, []string{"a", "b"}) {
+		t.Errorf("PeekN(2) = %v, %v, want [0 1], [a b]", ks, vs)
+	}
+	if k, v, ok := p.NextIf(func(k int, v string) bool { return v == "a" }); !ok || k != 0 || v != "a" {
+		t.Errorf("NextIf() = %d, %q, %v, want 0, \"a\", true", k, v, ok)
+	}
+	p.Unread(9, "z")
+
+	got := collect2(p.Seq())
+	want := []KV[int, string]{{9, "z"}, {1, "b"}, {2, "c"}}
+	if !slices.Equal(got, want) {
+		t.Errorf("Seq() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iters
+
+import (
+	"container/heap"
+	"fmt"
+	"iter"
+	"math/rand/v2"
+	"slices"
+)
+
+// boundedHeap is a heap of at most k values. Its root is the value that is evicted first,
+// i.e. the value for which evict(root, v) is true for all other values v.
+type boundedHeap[V any] struct {
+	vs    []V
+	evict func(V, V) bool
+}
+
+func (h *boundedHeap[V]) Len() int           { return len(h.vs) }
+func (h *boundedHeap[V]) Less(i, j int) bool { return h.evict(h.vs[i], h.vs[j]) }
+func (h *boundedHeap[V]) Swap(i, j int)      { h.vs[i], h.vs[j] = h.vs[j], h.vs[i] }
+func (h *boundedHeap[V]) Push(x any)         { h.vs = append(h.vs, x.(V)) }
+func (h *boundedHeap[V]) Pop() any {
+	n := len(h.vs)
+	v := h.vs[n-1]
+	var zero V
+	h.vs[n-1] = zero
+	h.vs = h.vs[:n-1]
+	return v
+}
+
+// offer adds v to the heap, evicting the root if the heap already holds k values and v is to be kept over it.
+func (h *boundedHeap[V]) offer(k int, v V) {
+	if len(h.vs) < k {
+		heap.Push(h, v)
+		return
+	}
+	if h.evict(h.vs[0], v) {
+		h.vs[0] = v
+		heap.Fix(h, 0)
+	}
+}
+
+func checkK(name string, k int) {
+	if k < 0 {
+		panic(fmt.Sprintf("iters.%s: negative k", name))
+	}
+}
+
+func topK[V any](seq iter.Seq2[V, error], k int, compare func(V, V) int) ([]V, error) {
+	h := &boundedHeap[V]{
+		vs:    make([]V, 0, k),
+		evict: func(a, b V) bool { return compare(a, b) < 0 },
+	}
+	if k > 0 {
+		for v, err := range seq {
+			if err != nil {
+				return nil, err
+			}
+			h.offer(k, v)
+		}
+	}
+	slices.SortFunc(h.vs, func(a, b V) int { return compare(b, a) })
+	return h.vs, nil
+}
+
+// TopK returns the k largest values in seq according to compare, sorted from largest to smallest.
+// If seq has fewer than k values, all of them are returned.
+// It uses O(k) memory, regardless of the length of seq.
+func TopK[V any](seq iter.Seq[V], k int, compare func(V, V) int) []V {
+	checkK("TopK", k)
+	res, _ := topK(LiftSuccess(seq), k, compare)
+	return res
+}
+
+// TryTopK returns the k largest values in seq according to compare, sorted from largest to smallest.
+// See TopK for details.
+// If seq contains an error, it returns nil and the error.
+func TryTopK[V any](seq iter.Seq2[V, error], k int, compare func(V, V) int) ([]V, error) {
+	checkK("TryTopK", k)
+	return topK(seq, k, compare)
+}
+
+// BottomK returns the k smallest values in seq according to compare, sorted from smallest to largest.
+// If seq has fewer than k values, all of them are returned.
+// It uses O(k) memory, regardless of the length of seq.
+func BottomK[V any](seq iter.Seq[V], k int, compare func(V, V) int) []V {
+	checkK("BottomK", k)
+	res, _ := topK(LiftSuccess(seq), k, func(a, b V) int { return compare(b, a) })
+	return res
+}
+
+// TryBottomK returns the k smallest values in seq according to compare, sorted from smallest to largest.
+// See BottomK for details.
+// If seq contains an error, it returns nil and the error.
+func TryBottomK[V any](seq iter.Seq2[V, error], k int, compare func(V, V) int) ([]V, error) {
+	checkK("TryBottomK", k)
+	return topK(seq, k, func(a, b V) int { return compare(b, a) })
+}
+
+func intN(r *rand.Rand, n int) int {
+	if r == nil {
+		return rand.IntN(n)
+	}
+	return r.IntN(n)
+}
+
+func sample[V any](seq iter.Seq2[V, error], k int, r *rand.Rand) ([]V, error) {
+	res := make([]V, 0, k)
+	if k == 0 {
+		return res, nil
+	}
+
+	var i int
+	for v, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+
+		if i < k {
+			res = append(res, v)
+		} else if j := intN(r, i+1); j < k {
+			res[j] = v
+		}
+		i++
+	}
+	return res, nil
+}
+
+// Sample returns k values chosen uniformly at random from seq, using reservoir sampling.
+// If seq has fewer than k values, all of them are returned.
+// Random numbers are drawn from r; if r is nil, the top-level functions of `math/rand/v2` are used.
+// It uses O(k) memory, regardless of the length of seq. The order of the returned values is unspecified.
+func Sample[V any](seq iter.Seq[V], k int, r *rand.Rand) []V {
+	checkK("Sample", k)
+	res, _ := sample(LiftSuccess(seq), k, r)
+	return res
+}
+
+// TrySample returns k values chosen uniformly at random from seq, using reservoir sampling.
+// See Sample for details.
+// If seq contains an error, it returns nil and the error.
+func TrySample[V any](seq iter.Seq2[V, error], k int, r *rand.Rand) ([]V, error) {
+	checkK("TrySample", k)
+	return sample(seq, k, r)
+}
+
+func shuffle[V any](s []V, r *rand.Rand) []V {
+	swap := func(i, j int) { s[i], s[j] = s[j], s[i] }
+	if r == nil {
+		rand.Shuffle(len(s), swap)
+	} else {
+		r.Shuffle(len(s), swap)
+	}
+	return s
+}
+
+// Shuffle collects all values of seq into a slice in random order.
+// Random numbers are drawn from r; if r is nil, the top-level functions of `math/rand/v2` are used.
+func Shuffle[V any](seq iter.Seq[V], r *rand.Rand) []V {
+	return shuffle(slices.Collect(seq), r)
+}
+
+// TryShuffle collects all values of seq into a slice in random order.
+// See Shuffle for details.
+// If seq contains an error, it returns nil and the error.
+func TryShuffle[V any](seq iter.Seq2[V, error], r *rand.Rand) ([]V, error) {
+	vs, errp := SplitError(seq)
+	s := slices.Collect(vs)
+	if *errp != nil {
+		return nil, *errp
+	}
+	return shuffle(s, r), nil
+}
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iters
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestTopK(t *testing.T) {
+	seq := Of(5, 1, 9, 3, 7, 2, 8)
+	if got, want := TopK(seq, 3, cmp.Compare[int]), []int{9, 8, 7}; !slices.Equal(got, want) {
+		t.Errorf("TopK() = %v, want %v", got, want)
+	}
+	if got, want := BottomK(seq, 3, cmp.Compare[int]), []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("BottomK() = %v, want %v", got, want)
+	}
+	if got, want := TopK(Of(2, 1), 5, cmp.Compare[int]), []int{2, 1}; !slices.Equal(got, want) {
+		t.Errorf("TopK() = %v, want %v", got, want)
+	}
+	if got := TopK(seq, 0, cmp.Compare[int]); len(got) != 0 {
+		t.Errorf("TopK() = %v, want empty", got)
+	}
+}
+
+func TestTryTopK(t *testing.T) {
+	got, err := TryTopK(LiftSuccess(Of(1, 3, 2)), 2, cmp.Compare[int])
+	if err != nil || !slices.Equal(got, []int{3, 2}) {
+		t.Errorf("TryTopK() = %v, %v, want [3 2], nil", got, err)
+	}
+
+	seq := Concat2(LiftSuccess(Of(1)), Singleton2(0, errTest))
+	if _, err := TryBottomK(seq, 2, cmp.Compare[int]); err != errTest {
+		t.Errorf("TryBottomK() error = %v, want %v", err, errTest)
+	}
+}
+
+func TestSample(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	got := Sample(Range(0, 1000), 10, r)
+	if len(got) != 10 {
+		t.Fatalf("len(Sample()) = %d, want 10", len(got))
+	}
+	for _, v := range got {
+		if v < 0 || v >= 1000 {
+			t.Errorf("Sample() value %d out of range", v)
+		}
+	}
+	if len(slices.Compact(slices.Sorted(slices.Values(got)))) != 10 {
+		t.Errorf("Sample() = %v contains duplicates", got)
+	}
+
+	again := Sample(Range(0, 1000), 10, rand.New(rand.NewPCG(1, 2)))
+	if !slices.Equal(got, again) {
+		t.Errorf("Sample() not reproducible: %v != %v", got, again)
+	}
+
+	if got := Sample(Of(1, 2), 5, nil); len(got) != 2 {
+		t.Errorf("Sample() = %v, want 2 values", got)
+	}
+}
+
+func TestSampleUniform(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	var counts [10]int
+	for range 10000 {
+		for _, v := range Sample(Range(0, 10), 1, r) {
+			counts[v]++
+		}
+	}
+	for i, c := range counts {
+		if c < 800 || c > 1200 {
+			t.Errorf("Sample() picked %d %d times, want ~1000", i, c)
+		}
+	}
+}
+
+func TestTrySample(t *testing.T) {
+	seq := Concat2(LiftSuccess(Of(1)), Singleton2(0, errTest))
+	if _, err := TrySample(seq, 1, nil); err != errTest {
+		t.Errorf("TrySample() error = %v, want %v", err, errTest)
+	}
+}
+
+func TestShuffle(t *testing.T) {
+	got := Shuffle(Range(0, 100), rand.New(rand.NewPCG(1, 2)))
+	if slices.Equal(got, slices.Collect(Range(0, 100))) {
+		t.Error("Shuffle() did not change the order")
+	}
+	if !slices.Equal(slices.Sorted(slices.Values(got)), slices.Collect(Range(0, 100))) {
+		t.Errorf("Shuffle() = %v, not a permutation", got)
+	}
+
+	if _, err := TryShuffle(Concat2(LiftSuccess(Of(1)), Singleton2(0, errTest)), nil); err != errTest {
+		t.Errorf("TryShuffle() error = %v, want %v", err, errTest)
+	}
+}
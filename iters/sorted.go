@@ -0,0 +1,279 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iters
+
+import (
+	"cmp"
+	"container/heap"
+	"iter"
+)
+
+type mergeCursor[V any] struct {
+	idx  int
+	v    V
+	next func() (V, bool)
+}
+
+type mergeHeap[V any] struct {
+	cursors []*mergeCursor[V]
+	compare func(V, V) int
+}
+
+func (h *mergeHeap[V]) Len() int { return len(h.cursors) }
+
+func (h *mergeHeap[V]) Less(i, j int) bool {
+	if c := h.compare(h.cursors[i].v, h.cursors[j].v); c != 0 {
+		return c < 0
+	}
+	return h.cursors[i].idx < h.cursors[j].idx
+}
+
+func (h *mergeHeap[V]) Swap(i, j int) { h.cursors[i], h.cursors[j] = h.cursors[j], h.cursors[i] }
+
+func (h *mergeHeap[V]) Push(x any) { h.cursors = append(h.cursors, x.(*mergeCursor[V])) }
+
+func (h *mergeHeap[V]) Pop() any {
+	n := len(h.cursors)
+	c := h.cursors[n-1]
+	h.cursors[n-1] = nil
+	h.cursors = h.cursors[:n-1]
+	return c
+}
+
+// MergeSorted merges the sorted sequences seqs into a single sorted sequence.
+// Values that compare equal are yielded in the order of seqs.
+func MergeSorted[V cmp.Ordered](seqs ...iter.Seq[V]) iter.Seq[V] {
+	return MergeSortedFunc(cmp.Compare[V], seqs...)
+}
+
+// MergeSortedFunc merges the sequences seqs, each sorted by compare, into a single sorted sequence.
+// Values that compare equal are yielded in the order of seqs.
+func MergeSortedFunc[V any](compare func(V, V) int, seqs ...iter.Seq[V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		h := &mergeHeap[V]{compare: compare}
+		for i, seq := range seqs {
+			next, stop := iter.Pull(seq)
+			defer stop()
+
+			if v, ok := next(); ok {
+				h.cursors = append(h.cursors, &mergeCursor[V]{idx: i, v: v, next: next})
+			}
+		}
+		heap.Init(h)
+
+		for h.Len() > 0 {
+			c := h.cursors[0]
+			if !yield(c.v) {
+				return
+			}
+
+			var ok bool
+			if c.v, ok = c.next(); ok {
+				heap.Fix(h, 0)
+			} else {
+				heap.Pop(h)
+			}
+		}
+	}
+}
+
+type sortedSetOp int
+
+const (
+	sortedUnion sortedSetOp = iota
+	sortedIntersect
+	sortedDifference
+)
+
+func sortedSet[V any](op sortedSetOp, seq1, seq2 iter.Seq[V], compare func(V, V) int) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		next1, stop1 := iter.Pull(seq1)
+		defer stop1()
+		next2, stop2 := iter.Pull(seq2)
+		defer stop2()
+
+		v1, ok1 := next1()
+		v2, ok2 := next2()
+		for ok1 || ok2 {
+			switch {
+			case !ok2 || ok1 && compare(v1, v2) < 0:
+				if op != sortedIntersect && !yield(v1) {
+					return
+				}
+				v1, ok1 = next1()
+			case !ok1 || compare(v1, v2) > 0:
+				if op == sortedUnion && !yield(v2) {
+					return
+				}
+				v2, ok2 = next2()
+			default:
+				if op != sortedDifference && !yield(v1) {
+					return
+				}
+				v1, ok1 = next1()
+				v2, ok2 = next2()
+			}
+
+			if op == sortedIntersect && (!ok1 || !ok2) || op == sortedDifference && !ok1 {
+				return
+			}
+		}
+	}
+}
+
+// UnionSorted returns a new sorted iterator that yields the values contained in seq1 or seq2,
+// which both have to be sorted.
+// A value contained n1 times in seq1 and n2 times in seq2 is yielded max(n1, n2) times.
+func UnionSorted[V cmp.Ordered](seq1, seq2 iter.Seq[V]) iter.Seq[V] {
+	return UnionSortedFunc(seq1, seq2, cmp.Compare[V])
+}
+
+// UnionSortedFunc returns a new sorted iterator that yields the values contained in seq1 or seq2,
+// which both have to be sorted by compare.
+// A value contained n1 times in seq1 and n2 times in seq2 is yielded max(n1, n2) times.
+func UnionSortedFunc[V any](seq1, seq2 iter.Seq[V], compare func(V, V) int) iter.Seq[V] {
+	return sortedSet(sortedUnion, seq1, seq2, compare)
+}
+
+// IntersectSorted returns a new sorted iterator that yields the values contained in both seq1 and seq2,
+// which both have to be sorted.
+// A value contained n1 times in seq1 and n2 times in seq2 is yielded min(n1, n2) times.
+func IntersectSorted[V cmp.Ordered](seq1, seq2 iter.Seq[V]) iter.Seq[V] {
+	return IntersectSortedFunc(seq1, seq2, cmp.Compare[V])
+}
+
+// IntersectSortedFunc returns a new sorted iterator that yields the values contained in both seq1 and seq2,
+// which both have to be sorted by compare.
+// A value contained n1 times in seq1 and n2 times in seq2 is yielded min(n1, n2) times.
+func IntersectSortedFunc[V any](seq1, seq2 iter.Seq[V], compare func(V, V) int) iter.Seq[V] {
+	return sortedSet(sortedIntersect, seq1, seq2, compare)
+}
+
+// DifferenceSorted returns a new sorted iterator that yields the values contained in seq1 but not in seq2,
+// which both have to be sorted.
+// A value contained n1 times in seq1 and n2 times in seq2 is yielded max(n1-n2, 0) times.
+func DifferenceSorted[V cmp.Ordered](seq1, seq2 iter.Seq[V]) iter.Seq[V] {
+	return DifferenceSortedFunc(seq1, seq2, cmp.Compare[V])
+}
+
+// DifferenceSortedFunc returns a new sorted iterator that yields the values contained in seq1 but not in seq2,
+// which both have to be sorted by compare.
+// A value contained n1 times in seq1 and n2 times in seq2 is yielded max(n1-n2, 0) times.
+func DifferenceSortedFunc[V any](seq1, seq2 iter.Seq[V], compare func(V, V) int) iter.Seq[V] {
+	return sortedSet(sortedDifference, seq1, seq2, compare)
+}
+
+// Joined is the result of joining two key-value sequences on their keys.
+// HasLeft and HasRight report whether Left and Right are present.
+// For inner joins both are always present.
+type Joined[V1, V2 any] struct {
+	Left     V1
+	Right    V2
+	HasLeft  bool
+	HasRight bool
+}
+
+type sortedJoinKind int
+
+const (
+	sortedJoinInner sortedJoinKind = iota
+	sortedJoinLeft
+	sortedJoinOuter
+)
+
+func sortedJoin[K, V1, V2 any](kind sortedJoinKind, seq1 iter.Seq2[K, V1], seq2 iter.Seq2[K, V2], compare func(K, K) int) iter.Seq2[K, Joined[V1, V2]] {
+	return func(yield func(K, Joined[V1, V2]) bool) {
+		next1, stop1 := iter.Pull2(seq1)
+		defer stop1()
+		next2, stop2 := iter.Pull2(seq2)
+		defer stop2()
+
+		var (
+			k1, v1, ok1 = next1()
+			k2, v2, ok2 = next2()
+			run         []V2
+		)
+		for ok1 || ok2 {
+			switch {
+			case !ok2 || ok1 && compare(k1, k2) < 0:
+				if kind == sortedJoinInner {
+					if !ok2 {
+						return
+					}
+				} else if !yield(k1, Joined[V1, V2]{Left: v1, HasLeft: true}) {
+					return
+				}
+				k1, v1, ok1 = next1()
+			case !ok1 || compare(k1, k2) > 0:
+				if kind != sortedJoinOuter {
+					if !ok1 {
+						return
+					}
+				} else if !yield(k2, Joined[V1, V2]{Right: v2, HasRight: true}) {
+					return
+				}
+				k2, v2, ok2 = next2()
+			default:
+				// Buffer the run of right values with the current key to join
+				// every left value with the same key against all of them.
+				key := k2
+				run = run[:0]
+				for ok2 && compare(k2, key) == 0 {
+					run = append(run, v2)
+					k2, v2, ok2 = next2()
+				}
+				for ok1 && compare(k1, key) == 0 {
+					for _, r := range run {
+						if !yield(k1, Joined[V1, V2]{Left: v1, Right: r, HasLeft: true, HasRight: true}) {
+							return
+						}
+					}
+					k1, v1, ok1 = next1()
+				}
+			}
+		}
+	}
+}
+
+// JoinSorted returns a new iterator that performs an inner merge-join of seq1 and seq2 on their keys.
+// Both sequences have to be sorted by key. For every pair of entries with equal keys, the key and
+// the joined values are yielded. Only a run of equal keys of seq2 is buffered at a time.
+func JoinSorted[K cmp.Ordered, V1, V2 any](seq1 iter.Seq2[K, V1], seq2 iter.Seq2[K, V2]) iter.Seq2[K, Joined[V1, V2]] {
+	return JoinSortedFunc(seq1, seq2, cmp.Compare[K])
+}
+
+// JoinSortedFunc returns a new iterator that performs an inner merge-join of seq1 and seq2 on their keys.
+// Both sequences have to be sorted by key using compare.
+// See JoinSorted for details.
+func JoinSortedFunc[K, V1, V2 any](seq1 iter.Seq2[K, V1], seq2 iter.Seq2[K, V2], compare func(K, K) int) iter.Seq2[K, Joined[V1, V2]] {
+	return sortedJoin(sortedJoinInner, seq1, seq2, compare)
+}
+
+// LeftJoinSorted returns a new iterator that performs a left outer merge-join of seq1 and seq2 on their keys.
+// Both sequences have to be sorted by key. In addition to the entries yielded by JoinSorted,
+// entries of seq1 without a matching key in seq2 are yielded with HasRight unset.
+func LeftJoinSorted[K cmp.Ordered, V1, V2 any](seq1 iter.Seq2[K, V1], seq2 iter.Seq2[K, V2]) iter.Seq2[K, Joined[V1, V2]] {
+	return LeftJoinSortedFunc(seq1, seq2, cmp.Compare[K])
+}
+
+// LeftJoinSortedFunc returns a new iterator that performs a left outer merge-join of seq1 and seq2 on their keys.
+// Both sequences have to be sorted by key using compare.
+// See LeftJoinSorted for details.
+func LeftJoinSortedFunc[K, V1, V2 any](seq1 iter.Seq2[K, V1], seq2 iter.Seq2[K, V2], compare func(K, K) int) iter.Seq2[K, Joined[V1, V2]] {
+	return sortedJoin(sortedJoinLeft, seq1, seq2, compare)
+}
+
+// OuterJoinSorted returns a new iterator that performs a full outer merge-join of seq1 and seq2 on their keys.
+// Both sequences have to be sorted by key. In addition to the entries yielded by JoinSorted,
+// entries of either sequence without a matching key in the other are yielded with HasRight or HasLeft unset.
+func OuterJoinSorted[K cmp.Ordered, V1, V2 any](seq1 iter.Seq2[K, V1], seq2 iter.Seq2[K, V2]) iter.Seq2[K, Joined[V1, V2]] {
+	return OuterJoinSortedFunc(seq1, seq2, cmp.Compare[K])
+}
+
+// OuterJoinSortedFunc returns a new iterator that performs a full outer merge-join of seq1 and seq2 on their keys.
+// Both sequences have to be sorted by key using compare.
+// See OuterJoinSorted for details.
+func OuterJoinSortedFunc[K, V1, V2 any](seq1 iter.Seq2[K, V1], seq2 iter.Seq2[K, V2], compare func(K, K) int) iter.Seq2[K, Joined[V1, V2]] {
+	return sortedJoin(sortedJoinOuter, seq1, seq2, compare)
+}
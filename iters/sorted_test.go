@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iters
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func TestMergeSorted(t *testing.T) {
+	got := slices.Collect(MergeSorted(Of(1, 4, 7), Of(2, 5, 8), Empty[int](), Of(0, 3, 6, 9)))
+	want := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	if !slices.Equal(got, want) {
+		t.Errorf("MergeSorted() = %v, want %v", got, want)
+	}
+
+	got = slices.Collect(Take(MergeSorted(Range(0, 100), Range(0, 100)), 3))
+	want = []int{0, 0, 1}
+	if !slices.Equal(got, want) {
+		t.Errorf("MergeSorted() = %v, want %v", got, want)
+	}
+}
+
+func TestMergeSortedFuncStable(t *testing.T) {
+	type item struct {
+		key int
+		src string
+	}
+	compare := func(a, b item) int { return cmp.Compare(a.key, b.key) }
+	got := slices.Collect(MergeSortedFunc(compare,
+		Of(item{1, "a"}, item{2, "a"}),
+		Of(item{1, "b"}, item{2, "b"}),
+	))
+	want := []item{{1, "a"}, {1, "b"}, {2, "a"}, {2, "b"}}
+	if !slices.Equal(got, want) {
+		t.Errorf("MergeSortedFunc() = %v, want %v", got, want)
+	}
+}
+
+func TestSortedSetOps(t *testing.T) {
+	s1 := Of(1, 2, 2, 3, 5, 8)
+	s2 := Of(2, 3, 4, 8, 9)
+
+	tests := []struct {
+		name string
+		got  []int
+		want []int
+	}{
+		{"UnionSorted", slices.Collect(UnionSorted(s1, s2)), []int{1, 2, 2, 3, 4, 5, 8, 9}},
+		{"IntersectSorted", slices.Collect(IntersectSorted(s1, s2)), []int{2, 3, 8}},
+		{"DifferenceSorted", slices.Collect(DifferenceSorted(s1, s2)), []int{1, 2, 5}},
+		{"DifferenceSorted empty", slices.Collect(DifferenceSorted(Empty[int](), s2)), nil},
+		{"UnionSorted empty", slices.Collect(UnionSorted(Empty[int](), s2)), []int{2, 3, 4, 8, 9}},
+	}
+	for _, tt := range tests {
+		if !slices.Equal(tt.got, tt.want) {
+			t.Errorf("%s() = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestJoinSorted(t *testing.T) {
+	left := func(yield func(int, string) bool) {
+		_ = yield(1, "a") && yield(2, "b") && yield(2, "c") && yield(4, "d")
+	}
+	right := func(yield func(int, float64) bool) {
+		_ = yield(2, 0.1) && yield(2, 0.2) && yield(3, 0.3) && yield(4, 0.4) && yield(5, 0.5)
+	}
+
+	both := func(l string, r float64) Joined[string, float64] {
+		return Joined[string, float64]{Left: l, Right: r, HasLeft: true, HasRight: true}
+	}
+	onlyLeft := func(l string) Joined[string, float64] {
+		return Joined[string, float64]{Left: l, HasLeft: true}
+	}
+	onlyRight := func(r float64) Joined[string, float64] {
+		return Joined[string, float64]{Right: r, HasRight: true}
+	}
+
+	inner := []KV[int, Joined[string, float64]]{
+		{2, both("b", 0.1)}, {2, both("b", 0.2)}, {2, both("c", 0.1)}, {2, both("c", 0.2)}, {4, both("d", 0.4)},
+	}
+	if got := collect2(JoinSorted(left, right)); !slices.Equal(got, inner) {
+		t.Errorf("JoinSorted() = %v, want %v", got, inner)
+	}
+
+	leftJoin := slices.Concat([]KV[int, Joined[string, float64]]{{1, onlyLeft("a")}}, inner)
+	if got := collect2(LeftJoinSorted(left, right)); !slices.Equal(got, leftJoin) {
+		t.Errorf("LeftJoinSorted() = %v, want %v", got, leftJoin)
+	}
+
+	outer := []KV[int, Joined[string, float64]]{
+		{1, onlyLeft("a")},
+		{2, both("b", 0.1)}, {2, both("b", 0.2)}, {2, both("c", 0.1)}, {2, both("c", 0.2)},
+		{3, onlyRight(0.3)},
+		{4, both("d", 0.4)},
+		{5, onlyRight(0.5)},
+	}
+	if got := collect2(OuterJoinSorted(left, right)); !slices.Equal(got, outer) {
+		t.Errorf("OuterJoinSorted() = %v, want %v", got, outer)
+	}
+}
@@ -0,0 +1,412 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iters
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"math"
+	"slices"
+
+	"spheric.cloud/xstd/constraints"
+)
+
+// welford accumulates the running mean and variance using Welford's online algorithm.
+type welford struct {
+	n    int
+	mean float64
+	m2   float64
+}
+
+func (w *welford) add(x float64) {
+	w.n++
+	d := x - w.mean
+	w.mean += d / float64(w.n)
+	w.m2 += d * (x - w.mean)
+}
+
+func (w *welford) variance() float64 {
+	return w.m2 / float64(w.n)
+}
+
+func welfordOf[V constraints.Number](seq iter.Seq[V]) welford {
+	var w welford
+	for v := range seq {
+		w.add(float64(v))
+	}
+	return w
+}
+
+func tryWelfordOf[V constraints.Number](seq iter.Seq2[V, error]) (welford, error) {
+	var w welford
+	for v, err := range seq {
+		if err != nil {
+			return w, err
+		}
+		w.add(float64(v))
+	}
+	return w, nil
+}
+
+// Mean returns the arithmetic mean of all values in seq.
+// It panics if seq is empty.
+func Mean[V constraints.Number](seq iter.Seq[V]) float64 {
+	w := welfordOf(seq)
+	if w.n == 0 {
+		panic("iters.Mean: empty seq")
+	}
+	return w.mean
+}
+
+// TryMean returns the arithmetic mean of all values in seq.
+// It panics if seq is empty.
+// If seq contains an error, it returns zero and the error.
+func TryMean[V constraints.Number](seq iter.Seq2[V, error]) (float64, error) {
+	w, err := tryWelfordOf(seq)
+	if err != nil {
+		return 0, err
+	}
+	if w.n == 0 {
+		panic("iters.TryMean: empty seq")
+	}
+	return w.mean, nil
+}
+
+// Variance returns the population variance of all values in seq.
+// It is computed in a single pass using Welford's algorithm.
+// It panics if seq is empty.
+func Variance[V constraints.Number](seq iter.Seq[V]) float64 {
+	w := welfordOf(seq)
+	if w.n == 0 {
+		panic("iters.Variance: empty seq")
+	}
+	return w.variance()
+}
+
+// TryVariance returns the population variance of all values in seq.
+// It panics if seq is empty.
+// If seq contains an error, it returns zero and the error.
+func TryVariance[V constraints.Number](seq iter.Seq2[V, error]) (float64, error) {
+	w, err := tryWelfordOf(seq)
+	if err != nil {
+		return 0, err
+	}
+	if w.n == 0 {
+		panic("iters.TryVariance: empty seq")
+	}
+	return w.variance(), nil
+}
+
+// StdDev returns the population standard deviation of all values in seq.
+// It panics if seq is empty.
+func StdDev[V constraints.Number](seq iter.Seq[V]) float64 {
+	w := welfordOf(seq)
+	if w.n == 0 {
+		panic("iters.StdDev: empty seq")
+	}
+	return math.Sqrt(w.variance())
+}
+
+// TryStdDev returns the population standard deviation of all values in seq.
+// It panics if seq is empty.
+// If seq contains an error, it returns zero and the error.
+func TryStdDev[V constraints.Number](seq iter.Seq2[V, error]) (float64, error) {
+	w, err := tryWelfordOf(seq)
+	if err != nil {
+		return 0, err
+	}
+	if w.n == 0 {
+		panic("iters.TryStdDev: empty seq")
+	}
+	return math.Sqrt(w.variance()), nil
+}
+
+// MinMax returns the minimum and maximum value in seq in a single pass.
+// It panics if seq is empty.
+func MinMax[V cmp.Ordered](seq iter.Seq[V]) (V, V) {
+	return minMaxFunc("MinMax", seq, cmp.Compare[V])
+}
+
+// MinMaxFunc returns the minimum and maximum value in seq in a single pass, using the given comparison function.
+// It panics if seq is empty.
+func MinMaxFunc[V any](seq iter.Seq[V], compare func(V, V) int) (V, V) {
+	return minMaxFunc("MinMaxFunc", seq, compare)
+}
+
+func minMaxFunc[V any](name string, seq iter.Seq[V], compare func(V, V) int) (V, V) {
+	var (
+		lo, hi V
+		ok     bool
+	)
+	for v := range seq {
+		if !ok || compare(v, lo) < 0 {
+			lo = v
+		}
+		if !ok || compare(v, hi) > 0 {
+			hi = v
+		}
+		ok = true
+	}
+	if !ok {
+		panic(fmt.Sprintf("iters.%s: empty seq", name))
+	}
+	return lo, hi
+}
+
+// TryMinMax returns the minimum and maximum value in seq in a single pass.
+// It panics if seq is empty.
+// If seq contains an error, it returns the zero values and the error.
+func TryMinMax[V cmp.Ordered](seq iter.Seq2[V, error]) (V, V, error) {
+	var (
+		lo, hi V
+		ok     bool
+	)
+	for v, err := range seq {
+		if err != nil {
+			var zero V
+			return zero, zero, err
+		}
+		if !ok || v < lo {
+			lo = v
+		}
+		if !ok || v > hi {
+			hi = v
+		}
+		ok = true
+	}
+	if !ok {
+		panic("iters.TryMinMax: empty seq")
+	}
+	return lo, hi, nil
+}
+
+func checkQuantiles(name string, qs []float64) {
+	for _, q := range qs {
+		if !(q >= 0 && q <= 1) {
+			panic(fmt.Sprintf("iters.%s: quantile %v not in [0, 1]", name, q))
+		}
+	}
+}
+
+// nearestRank returns the q-quantile of the sorted slice s using the nearest-rank method.
+func nearestRank[V any](s []V, q float64) V {
+	idx := int(math.Ceil(q*float64(len(s)))) - 1
+	return s[max(0, min(idx, len(s)-1))]
+}
+
+// Quantiles returns the exact q-quantiles of all values in seq for each q in qs, using the nearest-rank method.
+// Every q has to be in [0, 1].
+// Quantiles stores and sorts all values of seq; use ApproxQuantiles for constant memory usage.
+// It panics if seq is empty.
+func Quantiles[V cmp.Ordered](seq iter.Seq[V], qs ...float64) []V {
+	checkQuantiles("Quantiles", qs)
+	s := slices.Sorted(seq)
+	if len(s) == 0 {
+		panic("iters.Quantiles: empty seq")
+	}
+	res := make([]V, len(qs))
+	for i, q := range qs {
+		res[i] = nearestRank(s, q)
+	}
+	return res
+}
+
+// TryQuantiles returns the exact q-quantiles of all values in seq for each q in qs.
+// See Quantiles for details.
+// If seq contains an error, it returns nil and the error.
+func TryQuantiles[V cmp.Ordered](seq iter.Seq2[V, error], qs ...float64) ([]V, error) {
+	checkQuantiles("TryQuantiles", qs)
+	vs, errp := SplitError(seq)
+	s := slices.Sorted(vs)
+	if *errp != nil {
+		return nil, *errp
+	}
+	if len(s) == 0 {
+		panic("iters.TryQuantiles: empty seq")
+	}
+	res := make([]V, len(qs))
+	for i, q := range qs {
+		res[i] = nearestRank(s, q)
+	}
+	return res, nil
+}
+
+// p2 estimates a single quantile using the P² algorithm by Jain and Chlamtac,
+// which keeps five markers instead of all observations.
+type p2 struct {
+	p    float64
+	n    int
+	q    [5]float64 // marker heights
+	pos  [5]float64 // actual marker positions
+	want [5]float64 // desired marker positions
+	inc  [5]float64 // increments of the desired marker positions
+}
+
+func newP2(p float64) *p2 {
+	return &p2{
+		p:    p,
+		pos:  [5]float64{1, 2, 3, 4, 5},
+		want: [5]float64{1, 1 + 2*p, 1 + 4*p, 3 + 2*p, 5},
+		inc:  [5]float64{0, p / 2, p, (1 + p) / 2, 1},
+	}
+}
+
+func (e *p2) add(x float64) {
+	if e.n < 5 {
+		e.q[e.n] = x
+		e.n++
+		return
+	}
+	if e.n == 5 {
+		slices.Sort(e.q[:])
+	}
+	e.n++
+
+	var k int
+	switch {
+	case x < e.q[0]:
+		e.q[0] = x
+		k = 0
+	case x >= e.q[4]:
+		e.q[4] = x
+		k = 3
+	default:
+		for k = 0; k < 3 && x >= e.q[k+1]; k++ {
+		}
+	}
+	for i := k + 1; i < 5; i++ {
+		e.pos[i]++
+	}
+	for i := range e.want {
+		e.want[i] += e.inc[i]
+	}
+
+	for i := 1; i <= 3; i++ {
+		d := e.want[i] - e.pos[i]
+		if d >= 1 && e.pos[i+1]-e.pos[i] > 1 || d <= -1 && e.pos[i-1]-e.pos[i] < -1 {
+			s := math.Copysign(1, d)
+			if q := e.parabolic(i, s); e.q[i-1] < q && q < e.q[i+1] {
+				e.q[i] = q
+			} else {
+				e.q[i] = e.linear(i, s)
+			}
+			e.pos[i] += s
+		}
+	}
+}
+
+func (e *p2) parabolic(i int, d float64) float64 {
+	return e.q[i] + d/(e.pos[i+1]-e.pos[i-1])*
+		((e.pos[i]-e.pos[i-1]+d)*(e.q[i+1]-e.q[i])/(e.pos[i+1]-e.pos[i])+
+			(e.pos[i+1]-e.pos[i]-d)*(e.q[i]-e.q[i-1])/(e.pos[i]-e.pos[i-1]))
+}
+
+func (e *p2) linear(i int, d float64) float64 {
+	j := i + int(d)
+	return e.q[i] + d*(e.q[j]-e.q[i])/(e.pos[j]-e.pos[i])
+}
+
+func (e *p2) value() float64 {
+	switch {
+	case e.n > 5 && e.p == 0:
+		// The outer markers track the minimum and maximum exactly.
+		return e.q[0]
+	case e.n > 5 && e.p == 1:
+		return e.q[4]
+	case e.n > 5:
+		return e.q[2]
+	}
+	s := slices.Clone(e.q[:e.n])
+	slices.Sort(s)
+	return nearestRank(s, e.p)
+}
+
+// ApproxQuantiles returns estimates of the q-quantiles of all values in seq for each q in qs.
+// Every q has to be in [0, 1].
+// The estimates are computed in a single pass and constant memory using the P² algorithm.
+// For at most five values, and for q = 0 and q = 1, the exact quantiles are returned.
+// It panics if seq is empty.
+func ApproxQuantiles[V constraints.Number](seq iter.Seq[V], qs ...float64) []float64 {
+	checkQuantiles("ApproxQuantiles", qs)
+	res, n := approxQuantiles(LiftSuccess(seq), qs)
+	if n == 0 {
+		panic("iters.ApproxQuantiles: empty seq")
+	}
+	return res
+}
+
+// TryApproxQuantiles returns estimates of the q-quantiles of all values in seq for each q in qs.
+// See ApproxQuantiles for details.
+// If seq contains an error, it returns nil and the error.
+func TryApproxQuantiles[V constraints.Number](seq iter.Seq2[V, error], qs ...float64) ([]float64, error) {
+	checkQuantiles("TryApproxQuantiles", qs)
+	vs, errp := SplitError(seq)
+	res, n := approxQuantiles(LiftSuccess(vs), qs)
+	if *errp != nil {
+		return nil, *errp
+	}
+	if n == 0 {
+		panic("iters.TryApproxQuantiles: empty seq")
+	}
+	return res, nil
+}
+
+func approxQuantiles[V constraints.Number](seq iter.Seq2[V, error], qs []float64) ([]float64, int) {
+	es := make([]*p2, len(qs))
+	for i, q := range qs {
+		es[i] = newP2(q)
+	}
+
+	var n int
+	for v := range seq {
+		n++
+		for _, e := range es {
+			e.add(float64(v))
+		}
+	}
+
+	res := make([]float64, len(es))
+	for i, e := range es {
+		res[i] = e.value()
+	}
+	return res, n
+}
+
+// Histogram counts the values in seq per bucket, where bounds are the sorted bucket boundaries.
+// The result has len(bounds)+1 counts: the count at index i is the number of values v with
+// bounds[i-1] <= v < bounds[i], where the first and last bucket are unbounded below and above.
+func Histogram[V cmp.Ordered](seq iter.Seq[V], bounds ...V) []int {
+	counts, _ := histogram("Histogram", LiftSuccess(seq), bounds)
+	return counts
+}
+
+// TryHistogram counts the values in seq per bucket, where bounds are the sorted bucket boundaries.
+// See Histogram for details.
+// If seq contains an error, it returns the counts of the values seen so far and the error.
+func TryHistogram[V cmp.Ordered](seq iter.Seq2[V, error], bounds ...V) ([]int, error) {
+	return histogram("TryHistogram", seq, bounds)
+}
+
+func histogram[V cmp.Ordered](name string, seq iter.Seq2[V, error], bounds []V) ([]int, error) {
+	if !slices.IsSorted(bounds) {
+		panic(fmt.Sprintf("iters.%s: bounds are not sorted", name))
+	}
+	counts := make([]int, len(bounds)+1)
+	for v, err := range seq {
+		if err != nil {
+			return counts, err
+		}
+
+		idx, found := slices.BinarySearch(bounds, v)
+		if found {
+			// Skip all bounds equal to v, as buckets are inclusive below.
+			for idx < len(bounds) && bounds[idx] == v {
+				idx++
+			}
+		}
+		counts[idx]++
+	}
+	return counts, nil
+}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iters
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func approxEqual(a, b, eps float64) bool {
+	return math.Abs(a-b) <= eps
+}
+
+func TestMeanVariance(t *testing.T) {
+	seq := Of(2, 4, 4, 4, 5, 5, 7, 9)
+	if got := Mean(seq); got != 5 {
+		t.Errorf("Mean() = %v, want 5", got)
+	}
+	if got := Variance(seq); !approxEqual(got, 4, 1e-12) {
+		t.Errorf("Variance() = %v, want 4", got)
+	}
+	if got := StdDev(seq); !approxEqual(got, 2, 1e-12) {
+		t.Errorf("StdDev() = %v, want 2", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Mean() on empty seq did not panic")
+		}
+	}()
+	Mean(Empty[float64]())
+}
+
+func TestTryMean(t *testing.T) {
+	got, err := TryMean(LiftSuccess(Of(1.0, 2.0)))
+	if err != nil || got != 1.5 {
+		t.Errorf("TryMean() = %v, %v, want 1.5, nil", got, err)
+	}
+
+	seq := Concat2(LiftSuccess(Of(1.0)), Singleton2(0.0, errTest))
+	if _, err := TryMean(seq); err != errTest {
+		t.Errorf("TryMean() error = %v, want %v", err, errTest)
+	}
+	if _, err := TryVariance(seq); err != errTest {
+		t.Errorf("TryVariance() error = %v, want %v", err, errTest)
+	}
+	if _, err := TryStdDev(seq); err != errTest {
+		t.Errorf("TryStdDev() error = %v, want %v", err, errTest)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	lo, hi := MinMax(Of(3, 1, 4, 1, 5, 9, 2, 6))
+	if lo != 1 || hi != 9 {
+		t.Errorf("MinMax() = %d, %d, want 1, 9", lo, hi)
+	}
+
+	sLo, sHi, err := TryMinMax(LiftSuccess(Of("b", "a", "c")))
+	if err != nil || sLo != "a" || sHi != "c" {
+		t.Errorf("TryMinMax() = %q, %q, %v, want \"a\", \"c\", nil", sLo, sHi, err)
+	}
+}
+
+func TestQuantiles(t *testing.T) {
+	got := Quantiles(Range(1, 101), 0, 0.5, 0.9, 1)
+	want := []int{1, 50, 90, 100}
+	if !slices.Equal(got, want) {
+		t.Errorf("Quantiles() = %v, want %v", got, want)
+	}
+
+	if _, err := TryQuantiles(Concat2(LiftSuccess(Of(1)), Singleton2(0, errTest)), 0.5); err != errTest {
+		t.Errorf("TryQuantiles() error = %v, want %v", err, errTest)
+	}
+}
+
+func TestApproxQuantiles(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	seq := func(yield func(float64) bool) {
+		for range 100000 {
+			if !yield(r.Float64() * 1000) {
+				return
+			}
+		}
+	}
+
+	got := ApproxQuantiles(seq, 0.5, 0.9, 0.99)
+	want := []float64{500, 900, 990}
+	for i := range want {
+		if !approxEqual(got[i], want[i], 10) {
+			t.Errorf("ApproxQuantiles()[%d] = %v, want ~%v", i, got[i], want[i])
+		}
+	}
+
+	for _, tc := range []struct {
+		values []int
+		qs     []float64
+		want   []float64
+	}{
+		{[]int{4}, []float64{0, 0.99, 1}, []float64{4, 4, 4}},
+		{[]int{4, 2, 3, 1}, []float64{0, 0.99, 1}, []float64{1, 4, 4}},
+		{[]int{5, 3, 1, 4, 2}, []float64{0, 0.5, 0.99, 1}, []float64{1, 3, 5, 5}},
+		{[]int{7, 1, 6, 2, 5, 3, 4}, []float64{0, 1}, []float64{1, 7}},
+		{slices.Collect(Range(1, 10001)), []float64{0, 1}, []float64{1, 10000}},
+	} {
+		if got := ApproxQuantiles(slices.Values(tc.values), tc.qs...); !slices.Equal(got, tc.want) {
+			t.Errorf("ApproxQuantiles(%d values, %v) = %v, want %v", len(tc.values), tc.qs, got, tc.want)
+		}
+	}
+
+	got, err := TryApproxQuantiles(LiftSuccess(Of(3, 1, 2)), 0.5)
+	if err != nil || got[0] != 2 {
+		t.Errorf("TryApproxQuantiles() = %v, %v, want [2], nil", got, err)
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	got := Histogram(Of(-1, 0, 1, 5, 10, 11, 100), 0, 10, 100)
+	want := []int{1, 3, 2, 1}
+	if !slices.Equal(got, want) {
+		t.Errorf("Histogram() = %v, want %v", got, want)
+	}
+
+	got, err := TryHistogram(Concat2(LiftSuccess(Of(1)), Singleton2(0, errTest)), 5)
+	if err != errTest || !slices.Equal(got, []int{1, 0}) {
+		t.Errorf("TryHistogram() = %v, %v, want [1 0], %v", got, err, errTest)
+	}
+}
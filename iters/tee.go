@@ -0,0 +1,258 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iters
+
+import (
+	"errors"
+	"iter"
+	"sync"
+)
+
+// ErrTeeOverflow is yielded by TeeBuffered to a consumer that lagged behind by more than the buffer limit
+// with the TeeError policy.
+var ErrTeeOverflow = errors.New("iters: tee consumer buffer overflow")
+
+// TeePolicy determines how TeeBuffered handles a consumer whose buffer is full.
+type TeePolicy int
+
+const (
+	// TeeBlock makes consumers wait until all lagging consumers have made room in their buffers.
+	// It requires every consumer to run in its own goroutine, otherwise the consumers deadlock.
+	TeeBlock TeePolicy = iota
+	// TeeError stops a lagging consumer once its buffer overflows. The consumer still receives
+	// the values buffered so far, followed by ErrTeeOverflow.
+	TeeError
+	// TeeDrop discards values for a lagging consumer while its buffer is full.
+	TeeDrop
+)
+
+type teeBuf[V any] struct {
+	vs     []V
+	active bool
+	failed bool
+}
+
+type tee[V any] struct {
+	mu      sync.Mutex
+	cond    sync.Cond
+	next    func() (V, bool)
+	stop    func()
+	pulling bool
+	done    bool
+	limit   int
+	policy  TeePolicy
+	bufs    []teeBuf[V]
+	err     error
+}
+
+func newTee[V any](seq iter.Seq[V], n, limit int, policy TeePolicy) *tee[V] {
+	t := &tee[V]{
+		limit:  limit,
+		policy: policy,
+		bufs:   make([]teeBuf[V], n),
+	}
+	t.cond.L = &t.mu
+	t.next, t.stop = iter.Pull(seq)
+	for i := range t.bufs {
+		t.bufs[i].active = true
+	}
+	return t
+}
+
+// full reports whether any active consumer other than i has a full buffer.
+func (t *tee[V]) full(i int) bool {
+	if t.limit <= 0 {
+		return false
+	}
+	for j := range t.bufs {
+		if j != i && t.bufs[j].active && len(t.bufs[j].vs) >= t.limit {
+			return true
+		}
+	}
+	return false
+}
+
+// distribute appends v to the buffers of all active consumers other than i, applying the policy.
+func (t *tee[V]) distribute(i int, v V) {
+	for j := range t.bufs {
+		b := &t.bufs[j]
+		if j == i || !b.active || b.failed {
+			continue
+		}
+		if t.limit > 0 && len(b.vs) >= t.limit {
+			switch t.policy {
+			case TeeDrop:
+				continue
+			case TeeError:
+				b.failed = true
+				if t.err == nil {
+					t.err = ErrTeeOverflow
+				}
+				continue
+			}
+		}
+		b.vs = append(b.vs, v)
+	}
+}
+
+// get returns the next value for consumer i, or ErrTeeOverflow once its buffer is drained
+// after it failed. t.mu has to be held.
+func (t *tee[V]) get(i int) (V, bool, error) {
+	b := &t.bufs[i]
+	for {
+		if len(b.vs) > 0 {
+			v := b.vs[0]
+			var zero V
+			b.vs[0] = zero // Zero the value to help GC
+			b.vs = b.vs[1:]
+			t.cond.Broadcast()
+			return v, true, nil
+		}
+		if b.failed {
+			var zero V
+			return zero, false, ErrTeeOverflow
+		}
+		if t.done {
+			var zero V
+			return zero, false, nil
+		}
+		if t.pulling || t.policy == TeeBlock && t.full(i) {
+			t.cond.Wait()
+			continue
+		}
+
+		v, ok := t.pull()
+		if !ok {
+			t.done = true
+			t.stop()
+			continue
+		}
+		t.distribute(i, v)
+		return v, true, nil
+	}
+}
+
+// pull pulls the next value without holding the lock, so other consumers can drain their buffers meanwhile.
+// t.mu has to be held, and is held again on return, even if the underlying sequence panics.
+func (t *tee[V]) pull() (V, bool) {
+	t.pulling = true
+	t.mu.Unlock()
+	defer func() {
+		t.mu.Lock()
+		t.pulling = false
+		t.cond.Broadcast()
+	}()
+	return t.next()
+}
+
+// yieldUnlocked calls yield without holding t.mu. t.mu has to be held, and is held again on return,
+// even if yield panics.
+func (t *tee[V]) yieldUnlocked(yield func(V, error) bool, v V, err error) bool {
+	t.mu.Unlock()
+	defer t.mu.Lock()
+	return yield(v, err)
+}
+
+func (t *tee[V]) deactivate(i int) {
+	t.bufs[i].active = false
+	t.bufs[i].vs = nil
+	t.cond.Broadcast()
+	for j := range t.bufs {
+		if t.bufs[j].active {
+			return
+		}
+	}
+	t.release()
+}
+
+// release stops the underlying iterator. t.mu has to be held.
+func (t *tee[V]) release() {
+	for t.pulling {
+		t.cond.Wait()
+	}
+	t.done = true
+	t.stop()
+	for j := range t.bufs {
+		t.bufs[j].vs = nil
+	}
+	t.cond.Broadcast()
+}
+
+func (t *tee[V]) seq(i int) iter.Seq2[V, error] {
+	return func(yield func(V, error) bool) {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		defer t.deactivate(i)
+
+		if !t.bufs[i].active {
+			return
+		}
+		for {
+			v, ok, err := t.get(i)
+			if err != nil {
+				t.yieldUnlocked(yield, v, err)
+				return
+			}
+			if !ok {
+				return
+			}
+
+			if !t.yieldUnlocked(yield, v, nil) {
+				return
+			}
+		}
+	}
+}
+
+func (t *tee[V]) close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.release()
+	return t.err
+}
+
+// Tee returns n iterators that each yield all values of seq, while seq is only ranged over once.
+// Values not yet consumed by every iterator are buffered without limit.
+// Each returned iterator may only be ranged over once.
+//
+// The returned function stops seq and discards all buffered values. It has to be called
+// if not all returned iterators are traversed to their end, and is safe to call multiple times.
+func Tee[V any](seq iter.Seq[V], n int) ([]iter.Seq[V], func()) {
+	if n < 0 {
+		panic("iters.Tee: negative n")
+	}
+	t := newTee(seq, n, 0, TeeDrop)
+	seqs := make([]iter.Seq[V], n)
+	for i := range seqs {
+		// Without a limit, no consumer can fail, so the errors are always nil.
+		seqs[i] = Keys(t.seq(i))
+	}
+	return seqs, func() { _ = t.close() }
+}
+
+// TeeBuffered returns n iterators that each yield all values of seq, while seq is only ranged over once.
+// Each iterator buffers at most limit values it has not consumed yet; policy determines what happens
+// if a consumer lags further behind. A limit of zero means the buffers are unbounded.
+// With the TeeError policy, the iterator of a lagging consumer ends by yielding ErrTeeOverflow;
+// otherwise, the yielded errors are always nil.
+// The returned iterators may be ranged over by different goroutines simultaneously, but each
+// of them only once.
+//
+// The returned function stops seq and discards all buffered values. It reports ErrTeeOverflow if any
+// consumer was stopped due to the TeeError policy. It has to be called if not all returned iterators
+// are traversed to their end, and is safe to call multiple times.
+func TeeBuffered[V any](seq iter.Seq[V], n, limit int, policy TeePolicy) ([]iter.Seq2[V, error], func() error) {
+	if n < 0 {
+		panic("iters.TeeBuffered: negative n")
+	}
+	if limit < 0 {
+		panic("iters.TeeBuffered: negative limit")
+	}
+	t := newTee(seq, n, limit, policy)
+	seqs := make([]iter.Seq2[V, error], n)
+	for i := range seqs {
+		seqs[i] = t.seq(i)
+	}
+	return seqs, t.close
+}
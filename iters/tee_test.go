@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iters
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestTee(t *testing.T) {
+	seq, pulled := onceSeq(1, 2, 3, 4)
+	seqs, stop := Tee(seq, 3)
+	defer stop()
+
+	if got, want := slices.Collect(Take(seqs[0], 2)), []int{1, 2}; !slices.Equal(got, want) {
+		t.Errorf("Tee()[0] = %v, want %v", got, want)
+	}
+	if got, want := slices.Collect(seqs[1]), []int{1, 2, 3, 4}; !slices.Equal(got, want) {
+		t.Errorf("Tee()[1] = %v, want %v", got, want)
+	}
+	if got, want := slices.Collect(seqs[2]), []int{1, 2, 3, 4}; !slices.Equal(got, want) {
+		t.Errorf("Tee()[2] = %v, want %v", got, want)
+	}
+	if *pulled != 4 {
+		t.Errorf("pulled = %d, want 4", *pulled)
+	}
+	if got := slices.Collect(seqs[0]); len(got) != 0 {
+		t.Errorf("Tee()[0] second range = %v, want empty", got)
+	}
+}
+
+func TestTeeStop(t *testing.T) {
+	seqs, stop := Tee(Range(0, 10), 2)
+	_, _ = First(seqs[0])
+	stop()
+	stop()
+	if got := slices.Collect(seqs[1]); len(got) != 0 {
+		t.Errorf("Tee()[1] after stop = %v, want empty", got)
+	}
+}
+
+func TestTeePanic(t *testing.T) {
+	seqs, stop := Tee(Of(1, 2, 3), 2)
+	defer stop()
+
+	func() {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("recover() = %v, want boom", r)
+			}
+		}()
+		for range seqs[0] {
+			panic("boom")
+		}
+	}()
+
+	if got, want := slices.Collect(seqs[1]), []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("Tee() = %v, want %v", got, want)
+	}
+}
+
+func TestTeeBufferedDrop(t *testing.T) {
+	seqs, stop := TeeBuffered(Range(0, 5), 2, 2, TeeDrop)
+
+	if got, want := slices.Collect(Keys(seqs[0])), []int{0, 1, 2, 3, 4}; !slices.Equal(got, want) {
+		t.Errorf("TeeBuffered()[0] = %v, want %v", got, want)
+	}
+	if got, want := slices.Collect(Keys(seqs[1])), []int{0, 1}; !slices.Equal(got, want) {
+		t.Errorf("TeeBuffered()[1] = %v, want %v", got, want)
+	}
+	if err := stop(); err != nil {
+		t.Errorf("stop() = %v, want nil", err)
+	}
+}
+
+func TestTeeBufferedError(t *testing.T) {
+	seqs, stop := TeeBuffered(Range(0, 5), 2, 2, TeeError)
+
+	if got, want := collect2(seqs[0]), []KV[int, error]{{0, nil}, {1, nil}, {2, nil}, {3, nil}, {4, nil}}; !slices.Equal(got, want) {
+		t.Errorf("TeeBuffered()[0] = %v, want %v", got, want)
+	}
+	if got, want := collect2(seqs[1]), []KV[int, error]{{0, nil}, {1, nil}, {0, ErrTeeOverflow}}; !slices.Equal(got, want) {
+		t.Errorf("TeeBuffered()[1] = %v, want %v", got, want)
+	}
+	if err := stop(); err != ErrTeeOverflow {
+		t.Errorf("stop() = %v, want %v", err, ErrTeeOverflow)
+	}
+}
+
+func TestTeeBufferedBlock(t *testing.T) {
+	const n = 1000
+	seqs, stop := TeeBuffered(Range(0, n), 3, 1, TeeBlock)
+	defer func() { _ = stop() }()
+
+	var (
+		wg   sync.WaitGroup
+		want = slices.Collect(Range(0, n))
+	)
+	for i, seq := range seqs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := slices.Collect(Keys(seq)); !slices.Equal(got, want) {
+				t.Errorf("TeeBuffered()[%d] = %v, want %v", i, got, want)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestTeeBufferedBlockEarlyStop(t *testing.T) {
+	seqs, stop := TeeBuffered(Range(0, 1000), 2, 1, TeeBlock)
+	defer func() { _ = stop() }()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_ = slices.Collect(Take(Keys(seqs[0]), 3))
+	}()
+	go func() {
+		defer wg.Done()
+		if got := len(slices.Collect(Keys(seqs[1]))); got != 1000 {
+			t.Errorf("len(TeeBuffered()[1]) = %d, want 1000", got)
+		}
+	}()
+	wg.Wait()
+}
@@ -0,0 +1,269 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iters
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+)
+
+func checkWindow(name string, size, step int) {
+	if size <= 0 {
+		panic(fmt.Sprintf("iters.%s: size must be > 0", name))
+	}
+	if step <= 0 {
+		panic(fmt.Sprintf("iters.%s: step must be > 0", name))
+	}
+}
+
+// Window returns a new iterator that yields windows of size values from seq,
+// where each window starts step values after the previous one.
+// If step < size, windows overlap (sliding windows). If step == size, windows are adjacent
+// (tumbling windows). If step > size, values between windows are skipped.
+// Only complete windows are yielded. Every yielded slice is newly allocated and may be retained.
+func Window[V any](seq iter.Seq[V], size, step int) iter.Seq[[]V] {
+	checkWindow("Window", size, step)
+	return func(yield func([]V) bool) {
+		var (
+			buf  = make([]V, 0, size)
+			skip int
+		)
+		for v := range seq {
+			if skip > 0 {
+				skip--
+				continue
+			}
+
+			buf = append(buf, v)
+			if len(buf) < size {
+				continue
+			}
+
+			if !yield(slices.Clone(buf)) {
+				return
+			}
+			if step < size {
+				buf = append(buf[:0], buf[step:]...)
+			} else {
+				buf = buf[:0]
+				skip = step - size
+			}
+		}
+	}
+}
+
+// Window2 returns a new iterator that yields windows of size key-value pairs from seq,
+// where each window starts step pairs after the previous one.
+// The keys and values of a window are yielded as two slices of equal length.
+// See Window for the semantics of size and step.
+func Window2[K, V any](seq iter.Seq2[K, V], size, step int) iter.Seq2[[]K, []V] {
+	checkWindow("Window2", size, step)
+	return func(yield func([]K, []V) bool) {
+		var (
+			kBuf = make([]K, 0, size)
+			vBuf = make([]V, 0, size)
+			skip int
+		)
+		for k, v := range seq {
+			if skip > 0 {
+				skip--
+				continue
+			}
+
+			kBuf = append(kBuf, k)
+			vBuf = append(vBuf, v)
+			if len(kBuf) < size {
+				continue
+			}
+
+			if !yield(slices.Clone(kBuf), slices.Clone(vBuf)) {
+				return
+			}
+			if step < size {
+				kBuf = append(kBuf[:0], kBuf[step:]...)
+				vBuf = append(vBuf[:0], vBuf[step:]...)
+			} else {
+				kBuf = kBuf[:0]
+				vBuf = vBuf[:0]
+				skip = step - size
+			}
+		}
+	}
+}
+
+// TryWindow returns a new iterator that yields windows of size values from seq.
+// See Window for the semantics of size and step.
+// If seq contains an error, it yields the error.
+func TryWindow[V any](seq iter.Seq2[V, error], size, step int) iter.Seq2[[]V, error] {
+	checkWindow("TryWindow", size, step)
+	return TryTransform(seq, func(seq iter.Seq[V]) iter.Seq[[]V] {
+		return Window(seq, size, step)
+	})
+}
+
+// Pairwise returns a new iterator that yields each pair of adjacent values from seq.
+// A sequence of n values yields n-1 pairs.
+func Pairwise[V any](seq iter.Seq[V]) iter.Seq2[V, V] {
+	return func(yield func(V, V) bool) {
+		var (
+			prev V
+			ok   bool
+		)
+		for v := range seq {
+			if ok && !yield(prev, v) {
+				return
+			}
+			prev = v
+			ok = true
+		}
+	}
+}
+
+// Pairwise2 returns a new iterator that yields each pair of adjacent key-value pairs from seq.
+// The keys and values of adjacent pairs are yielded as arrays of two.
+func Pairwise2[K, V any](seq iter.Seq2[K, V]) iter.Seq2[[2]K, [2]V] {
+	return func(yield func([2]K, [2]V) bool) {
+		var (
+			prevK K
+			prevV V
+			ok    bool
+		)
+		for k, v := range seq {
+			if ok && !yield([2]K{prevK, k}, [2]V{prevV, v}) {
+				return
+			}
+			prevK = k
+			prevV = v
+			ok = true
+		}
+	}
+}
+
+// TryPairwise returns a new iterator that yields each pair of adjacent values from seq as an array of two.
+// If seq contains an error, it yields the error.
+func TryPairwise[V any](seq iter.Seq2[V, error]) iter.Seq2[[2]V, error] {
+	return TryTransform(seq, func(seq iter.Seq[V]) iter.Seq[[2]V] {
+		return MapLower(Pairwise(seq), func(v1, v2 V) [2]V { return [2]V{v1, v2} })
+	})
+}
+
+// SplitWhen returns a new iterator that yields groups of consecutive values from seq.
+// A new group is started between two adjacent values prev and next if f(prev, next) returns true.
+// Every yielded slice is newly allocated and may be retained.
+func SplitWhen[V any](seq iter.Seq[V], f func(prev, next V) bool) iter.Seq[[]V] {
+	return func(yield func([]V) bool) {
+		var group []V
+		for v := range seq {
+			if len(group) > 0 && f(group[len(group)-1], v) {
+				if !yield(group) {
+					return
+				}
+				group = nil
+			}
+			group = append(group, v)
+		}
+		if len(group) > 0 {
+			yield(group)
+		}
+	}
+}
+
+// SplitWhen2 returns a new iterator that yields groups of consecutive key-value pairs from seq.
+// A new group is started between two adjacent pairs if f returns true for them.
+// The keys and values of a group are yielded as two slices of equal length.
+func SplitWhen2[K, V any](seq iter.Seq2[K, V], f func(prevK K, prevV V, nextK K, nextV V) bool) iter.Seq2[[]K, []V] {
+	return func(yield func([]K, []V) bool) {
+		var (
+			kGroup []K
+			vGroup []V
+		)
+		for k, v := range seq {
+			if n := len(kGroup); n > 0 && f(kGroup[n-1], vGroup[n-1], k, v) {
+				if !yield(kGroup, vGroup) {
+					return
+				}
+				kGroup = nil
+				vGroup = nil
+			}
+			kGroup = append(kGroup, k)
+			vGroup = append(vGroup, v)
+		}
+		if len(kGroup) > 0 {
+			yield(kGroup, vGroup)
+		}
+	}
+}
+
+// TrySplitWhen returns a new iterator that yields groups of consecutive values from seq.
+// See SplitWhen for the semantics of f.
+// If seq contains an error, it yields the error.
+func TrySplitWhen[V any](seq iter.Seq2[V, error], f func(prev, next V) bool) iter.Seq2[[]V, error] {
+	return TryTransform(seq, func(seq iter.Seq[V]) iter.Seq[[]V] {
+		return SplitWhen(seq, f)
+	})
+}
+
+// ChunkBy returns a new iterator that yields groups of consecutive values from seq
+// for which f returns the same key. A new group is started whenever the key changes.
+func ChunkBy[V any, Key comparable](seq iter.Seq[V], f func(V) Key) iter.Seq[[]V] {
+	return func(yield func([]V) bool) {
+		var (
+			group []V
+			key   Key
+		)
+		for v := range seq {
+			k := f(v)
+			if len(group) > 0 && k != key {
+				if !yield(group) {
+					return
+				}
+				group = nil
+			}
+			key = k
+			group = append(group, v)
+		}
+		if len(group) > 0 {
+			yield(group)
+		}
+	}
+}
+
+// ChunkBy2 returns a new iterator that yields groups of consecutive key-value pairs from seq
+// for which f returns the same key. A new group is started whenever the key changes.
+// The keys and values of a group are yielded as two slices of equal length.
+func ChunkBy2[K, V any, Key comparable](seq iter.Seq2[K, V], f func(K, V) Key) iter.Seq2[[]K, []V] {
+	return func(yield func([]K, []V) bool) {
+		var (
+			kGroup []K
+			vGroup []V
+			key    Key
+		)
+		for k, v := range seq {
+			newKey := f(k, v)
+			if len(kGroup) > 0 && newKey != key {
+				if !yield(kGroup, vGroup) {
+					return
+				}
+				kGroup = nil
+				vGroup = nil
+			}
+			key = newKey
+			kGroup = append(kGroup, k)
+			vGroup = append(vGroup, v)
+		}
+		if len(kGroup) > 0 {
+			yield(kGroup, vGroup)
+		}
+	}
+}
+
+// TryChunkBy returns a new iterator that yields groups of consecutive values from seq
+// for which f returns the same key.
+// If seq contains an error, it yields the error.
+func TryChunkBy[V any, Key comparable](seq iter.Seq2[V, error], f func(V) Key) iter.Seq2[[]V, error] {
+	return TryTransform(seq, func(seq iter.Seq[V]) iter.Seq[[]V] {
+		return ChunkBy(seq, f)
+	})
+}
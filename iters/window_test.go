@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2025 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iters
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestWindow(t *testing.T) {
+	tests := []struct {
+		size, step int
+		want       [][]int
+	}{
+		{3, 1, [][]int{{0, 1, 2}, {1, 2, 3}, {2, 3, 4}}},
+		{2, 2, [][]int{{0, 1}, {2, 3}}},
+		{2, 3, [][]int{{0, 1}, {3, 4}}},
+		{6, 1, nil},
+	}
+	for _, tt := range tests {
+		got := slices.Collect(Window(Range(0, 5), tt.size, tt.step))
+		if !slices.EqualFunc(got, tt.want, slices.Equal) {
+			t.Errorf("Window(%d, %d) = %v, want %v", tt.size, tt.step, got, tt.want)
+		}
+	}
+}
+
+func TestWindowRetain(t *testing.T) {
+	got := slices.Collect(Window(Range(0, 4), 2, 1))
+	got[0][1] = 42
+	if got[1][0] != 1 {
+		t.Errorf("Window() windows share memory: %v", got)
+	}
+}
+
+func TestWindow2(t *testing.T) {
+	var (
+		gotK [][]int
+		gotV [][]string
+	)
+	for ks, vs := range Window2(Enumerate[int](Of("a", "b", "c")), 2, 1) {
+		gotK = append(gotK, ks)
+		gotV = append(gotV, vs)
+	}
+	wantK := [][]int{{0, 1}, {1, 2}}
+	wantV := [][]string{{"a", "b"}, {"b", "c"}}
+	if !slices.EqualFunc(gotK, wantK, slices.Equal) || !slices.EqualFunc(gotV, wantV, slices.Equal) {
+		t.Errorf("Window2() = %v %v, want %v %v", gotK, gotV, wantK, wantV)
+	}
+}
+
+func TestTryWindow(t *testing.T) {
+	seq := Concat2(LiftSuccess(Of(1, 2)), Singleton2(0, errTest), LiftSuccess(Of(3)))
+	var (
+		got  [][]int
+		errs int
+	)
+	for w, err := range TryWindow(seq, 2, 1) {
+		if err != nil {
+			errs++
+			continue
+		}
+		got = append(got, w)
+	}
+	want := [][]int{{1, 2}, {2, 3}}
+	if !slices.EqualFunc(got, want, slices.Equal) || errs != 1 {
+		t.Errorf("TryWindow() = %v, %d errors, want %v, 1 error", got, errs, want)
+	}
+}
+
+func TestPairwise(t *testing.T) {
+	got := collect2(Pairwise(Of(1, 2, 3)))
+	want := []KV[int, int]{{1, 2}, {2, 3}}
+	if !slices.Equal(got, want) {
+		t.Errorf("Pairwise() = %v, want %v", got, want)
+	}
+
+	if got := collect2(Pairwise(Of(1))); len(got) != 0 {
+		t.Errorf("Pairwise() = %v, want empty", got)
+	}
+}
+
+func TestPairwise2(t *testing.T) {
+	got := collect2(Pairwise2(Enumerate[int](Of("a", "b", "c"))))
+	want := []KV[[2]int, [2]string]{{[2]int{0, 1}, [2]string{"a", "b"}}, {[2]int{1, 2}, [2]string{"b", "c"}}}
+	if !slices.Equal(got, want) {
+		t.Errorf("Pairwise2() = %v, want %v", got, want)
+	}
+}
+
+func TestTryPairwise(t *testing.T) {
+	got := collect2(TryPairwise(LiftSuccess(Of(1, 2, 3))))
+	want := []KV[[2]int, error]{{[2]int{1, 2}, nil}, {[2]int{2, 3}, nil}}
+	if !slices.Equal(got, want) {
+		t.Errorf("TryPairwise() = %v, want %v", got, want)
+	}
+}
+
+func TestSplitWhen(t *testing.T) {
+	got := slices.Collect(SplitWhen(Of(1, 2, 4, 5, 9), func(prev, next int) bool { return next-prev > 1 }))
+	want := [][]int{{1, 2}, {4, 5}, {9}}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("SplitWhen() = %v, want %v", got, want)
+	}
+
+	if got := slices.Collect(SplitWhen(Empty[int](), func(int, int) bool { return true })); len(got) != 0 {
+		t.Errorf("SplitWhen() = %v, want empty", got)
+	}
+}
+
+func TestSplitWhen2(t *testing.T) {
+	var got [][]int
+	for ks := range SplitWhen2(Enumerate[int](Of("a", "a", "b")), func(_ int, prev string, _ int, next string) bool {
+		return prev != next
+	}) {
+		got = append(got, ks)
+	}
+	want := [][]int{{0, 1}, {2}}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("SplitWhen2() = %v, want %v", got, want)
+	}
+}
+
+func TestChunkBy(t *testing.T) {
+	got := slices.Collect(ChunkBy(Of("apple", "avocado", "banana", "cherry", "cranberry"), func(s string) byte { return s[0] }))
+	want := [][]string{{"apple", "avocado"}, {"banana"}, {"cherry", "cranberry"}}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("ChunkBy() = %v, want %v", got, want)
+	}
+}
+
+func TestChunkBy2(t *testing.T) {
+	var got [][]string
+	for _, vs := range ChunkBy2(Enumerate[int](Of("a", "b", "c", "d")), func(k int, _ string) bool { return k < 1 }) {
+		got = append(got, vs)
+	}
+	want := [][]string{{"a"}, {"b", "c", "d"}}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("ChunkBy2() = %v, want %v", got, want)
+	}
+}
+
+func TestTryChunkBy(t *testing.T) {
+	seq := Concat2(LiftSuccess(Of(1, 1)), Singleton2(0, errTest), LiftSuccess(Of(2)))
+	var (
+		got  [][]int
+		errs int
+	)
+	for c, err := range TryChunkBy(seq, func(v int) int { return v }) {
+		if err != nil {
+			errs++
+			continue
+		}
+		got = append(got, c)
+	}
+	want := [][]int{{1, 1}, {2}}
+	if !slices.EqualFunc(got, want, slices.Equal) || errs != 1 {
+		t.Errorf("TryChunkBy() = %v, %d errors, want %v, 1 error", got, errs, want)
+	}
+}